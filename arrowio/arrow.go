@@ -0,0 +1,177 @@
+package arrowio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// FromRecord creates a DataFrame from an Arrow record. The columns are
+// converted with SeriesFromArrow, so Arrow nulls are loaded as missing
+// elements. The SelectColumns, Names, WithTypes and WithSchema options can be
+// used to choose, rename, convert and validate the loaded columns.
+func FromRecord(rec arrow.Record, options ...dataframe.LoadOption) dataframe.DataFrame {
+	tbl := array.NewTableFromRecords(rec.Schema(), []arrow.Record{rec})
+	defer tbl.Release()
+	return FromTable(tbl, options...)
+}
+
+// FromTable creates a DataFrame from an Arrow table, concatenating the chunks
+// of every column. It accepts the same options as FromRecord.
+func FromTable(tbl arrow.Table, options ...dataframe.LoadOption) dataframe.DataFrame {
+	schema := tbl.Schema()
+	names := make([]string, schema.NumFields())
+	for i := range names {
+		names[i] = schema.Field(i).Name
+	}
+	return dataframe.LoadColumns(names, func(indices []int) ([]series.Series, error) {
+		columns := make([]series.Series, len(indices))
+		for i, idx := range indices {
+			col, err := SeriesFromArrowChunked(tbl.Column(idx).Data(), names[idx])
+			if err != nil {
+				return nil, err
+			}
+			columns[i] = col
+		}
+		return columns, nil
+	}, options...)
+}
+
+// ToRecord builds an Arrow record with the columns of the DataFrame, converted
+// with SeriesToArrow. If mem is nil, memory.DefaultAllocator is used. The
+// caller is responsible for releasing the returned record.
+func ToRecord(df dataframe.DataFrame, mem memory.Allocator) (arrow.Record, error) {
+	if df.Err != nil {
+		return nil, df.Err
+	}
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+	names := df.Names()
+	fields := make([]arrow.Field, len(names))
+	arrays := make([]arrow.Array, len(names))
+	defer func() {
+		for _, arr := range arrays {
+			if arr != nil {
+				arr.Release()
+			}
+		}
+	}()
+	for i, name := range names {
+		arr, err := SeriesToArrow(df.Col(name), mem)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %v", name, err)
+		}
+		fields[i] = arrow.Field{Name: name, Type: arr.DataType(), Nullable: true}
+		arrays[i] = arr
+	}
+	return array.NewRecord(arrow.NewSchema(fields, nil), arrays, int64(df.Nrow())), nil
+}
+
+// ReadFile reads a DataFrame from an Arrow IPC file. Like ReadParquet, the
+// reader must also implement io.Seeker or have a `Size() int64` method. All the
+// record batches in the file are concatenated. It accepts the same options as
+// FromRecord.
+func ReadFile(r io.ReaderAt, options ...dataframe.LoadOption) dataframe.DataFrame {
+	rs, err := readerAtSeeker(r)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read arrow file: %v", err)}
+	}
+	fr, err := ipc.NewFileReader(rs)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read arrow file: %v", err)}
+	}
+	defer fr.Close()
+
+	recs := make([]arrow.Record, 0, fr.NumRecords())
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+	for i := 0; i < fr.NumRecords(); i++ {
+		rec, err := fr.RecordAt(i)
+		if err != nil {
+			return dataframe.DataFrame{Err: fmt.Errorf("read arrow file: %v", err)}
+		}
+		recs = append(recs, rec)
+	}
+	return loadRecords(fr.Schema(), recs, options)
+}
+
+// ReadStream reads a DataFrame from an Arrow IPC stream, concatenating all of
+// its record batches. It accepts the same options as FromRecord.
+func ReadStream(r io.Reader, options ...dataframe.LoadOption) dataframe.DataFrame {
+	sr, err := ipc.NewReader(r)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read arrow stream: %v", err)}
+	}
+	defer sr.Release()
+
+	var recs []arrow.Record
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+	for sr.Next() {
+		rec := sr.Record()
+		rec.Retain()
+		recs = append(recs, rec)
+	}
+	if err := sr.Err(); err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read arrow stream: %v", err)}
+	}
+	return loadRecords(sr.Schema(), recs, options)
+}
+
+func loadRecords(schema *arrow.Schema, recs []arrow.Record, options []dataframe.LoadOption) dataframe.DataFrame {
+	tbl := array.NewTableFromRecords(schema, recs)
+	defer tbl.Release()
+	return FromTable(tbl, options...)
+}
+
+// WriteFile writes the DataFrame to the given io.Writer as an Arrow IPC file
+// with a single record batch. Column types and missing elements are preserved,
+// see SeriesToArrow. The writer is not closed.
+func WriteFile(df dataframe.DataFrame, w io.Writer) error {
+	rec, err := ToRecord(df, nil)
+	if err != nil {
+		return fmt.Errorf("write arrow file: %v", err)
+	}
+	defer rec.Release()
+
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(rec.Schema()))
+	if err != nil {
+		return fmt.Errorf("write arrow file: %v", err)
+	}
+	if err := fw.Write(rec); err != nil {
+		fw.Close()
+		return fmt.Errorf("write arrow file: %v", err)
+	}
+	return fw.Close()
+}
+
+// WriteStream writes the DataFrame to the given io.Writer as an Arrow IPC
+// stream with a single record batch. Column types and missing elements are
+// preserved, see SeriesToArrow. The writer is not closed.
+func WriteStream(df dataframe.DataFrame, w io.Writer) error {
+	rec, err := ToRecord(df, nil)
+	if err != nil {
+		return fmt.Errorf("write arrow stream: %v", err)
+	}
+	defer rec.Release()
+
+	sw := ipc.NewWriter(w, ipc.WithSchema(rec.Schema()))
+	if err := sw.Write(rec); err != nil {
+		sw.Close()
+		return fmt.Errorf("write arrow stream: %v", err)
+	}
+	return sw.Close()
+}
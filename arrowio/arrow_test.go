@@ -0,0 +1,126 @@
+package arrowio
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+func TestToRecord(t *testing.T) {
+	a := dataframe.New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+		series.New([]interface{}{"2021-10-10T12:30:00.5Z", "2021-10-11", nil}, series.Time, "E"),
+	)
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	rec, err := ToRecord(a, mem)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer rec.Release()
+	if rec.NumRows() != 3 || rec.NumCols() != 5 {
+		t.Errorf("Different dimensions:\nA:3x5\nB:%dx%d", rec.NumRows(), rec.NumCols())
+	}
+
+	table := []struct {
+		options []dataframe.LoadOption
+		expDf   dataframe.DataFrame
+	}{
+		{
+			nil,
+			a,
+		},
+		{
+			[]dataframe.LoadOption{dataframe.SelectColumns("E", "A")},
+			a.Select([]string{"E", "A"}),
+		},
+		{
+			[]dataframe.LoadOption{
+				dataframe.Names("V", "W", "X", "Y", "Z"),
+				dataframe.WithTypes(map[string]series.Type{"W": series.Float}),
+			},
+			dataframe.New(
+				series.New([]interface{}{"a", nil, "c"}, series.String, "V"),
+				series.New([]interface{}{1, 2, nil}, series.Float, "W"),
+				series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "X"),
+				series.New([]interface{}{true, nil, false}, series.Bool, "Y"),
+				series.New([]interface{}{"2021-10-10T12:30:00.5Z", "2021-10-11", nil}, series.Time, "Z"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := FromRecord(rec, tc.options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	for i, options := range [][]dataframe.LoadOption{
+		{dataframe.SelectColumns("Z")},
+		{dataframe.Names("A", "B")},
+	} {
+		if b := FromRecord(rec, options...); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	a := dataframe.New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+		series.New([]interface{}{"2021-10-10T12:30:00.5Z", "2021-10-11", nil}, series.Time, "E"),
+	)
+
+	fileBuf := new(bytes.Buffer)
+	if err := WriteFile(a, fileBuf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	streamBuf := new(bytes.Buffer)
+	if err := WriteStream(a, streamBuf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	table := []struct {
+		df    dataframe.DataFrame
+		expDf dataframe.DataFrame
+	}{
+		{
+			ReadFile(bytes.NewReader(fileBuf.Bytes())),
+			a,
+		},
+		{
+			ReadStream(bytes.NewReader(streamBuf.Bytes())),
+			a,
+		},
+		{
+			ReadStream(bytes.NewReader(streamBuf.Bytes()), dataframe.SelectColumns("B", "D")),
+			a.Select([]string{"B", "D"}),
+		},
+	}
+	for i, tc := range table {
+		if tc.df.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, tc.df.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, tc.df)
+	}
+
+	if b := ReadFile(bytes.NewReader(streamBuf.Bytes())); b.Err == nil {
+		t.Errorf("Expected error reading a stream as a file")
+	}
+	if b := ReadStream(bytes.NewReader([]byte("not arrow"))); b.Err == nil {
+		t.Errorf("Expected error on invalid stream")
+	}
+}
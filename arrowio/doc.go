@@ -0,0 +1,5 @@
+// Package arrowio converts Series and DataFrames to and from Apache Arrow
+// arrays and records, and reads and writes DataFrames as Arrow IPC files and
+// streams and as Parquet files. It is a separate module so that the Arrow
+// libraries are only required by the programs that use it.
+package arrowio
@@ -0,0 +1,175 @@
+package arrowio
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// ReadParquet reads a Parquet file and builds a DataFrame with its columns.
+// The reader must also implement io.Seeker or have a `Size() int64` method,
+// as *os.File, *bytes.Reader and *io.SectionReader do.
+//
+// Parquet columns are loaded as follows:
+//
+//	INT64, smaller integers             // Int
+//	INT32                               // Int32
+//	INT64 (unsigned)                    // Uint64
+//	FLOAT                               // Float32
+//	DOUBLE                              // Float
+//	BOOLEAN                             // Bool
+//	BYTE_ARRAY (UTF8)                   // String
+//	TIMESTAMP, DATE                     // Time
+//
+// Null values are loaded as missing elements. If SelectColumns is given, only
+// those columns are read from the file, in that order. The Names, WithTypes
+// and WithSchema options can be used to rename, convert and validate the
+// loaded columns.
+func ReadParquet(r io.ReaderAt, options ...dataframe.LoadOption) dataframe.DataFrame {
+	rs, err := readerAtSeeker(r)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read parquet: %v", err)}
+	}
+	pf, err := file.NewParquetReader(rs)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read parquet: %v", err)}
+	}
+	defer pf.Close()
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read parquet: %v", err)}
+	}
+
+	schema := pf.MetaData().Schema
+	names := make([]string, schema.NumColumns())
+	for i := range names {
+		names[i] = schema.Column(i).Name()
+	}
+	rowGroups := make([]int, pf.NumRowGroups())
+	for i := range rowGroups {
+		rowGroups[i] = i
+	}
+	df := dataframe.LoadColumns(names, func(indices []int) ([]series.Series, error) {
+		tbl, err := fr.ReadRowGroups(context.Background(), indices, rowGroups)
+		if err != nil {
+			return nil, err
+		}
+		defer tbl.Release()
+		columns := make([]series.Series, tbl.NumCols())
+		for i := range columns {
+			col, err := SeriesFromArrowChunked(tbl.Column(i).Data(), tbl.Schema().Field(i).Name)
+			if err != nil {
+				return nil, err
+			}
+			columns[i] = col
+		}
+		return columns, nil
+	}, options...)
+	if df.Err != nil {
+		return dataframe.DataFrame{Err: fmt.Errorf("read parquet: %v", df.Err)}
+	}
+	return df
+}
+
+// readSeekerAt is implemented by the readers accepted by ReadParquet and
+// ReadFile.
+type readSeekerAt interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+}
+
+// readerAtSeeker adapts an io.ReaderAt to the interface required by the
+// Parquet and Arrow file readers.
+func readerAtSeeker(r io.ReaderAt) (readSeekerAt, error) {
+	if rs, ok := r.(readSeekerAt); ok {
+		return rs, nil
+	}
+	if sized, ok := r.(interface{ Size() int64 }); ok {
+		return io.NewSectionReader(r, 0, sized.Size()), nil
+	}
+	return nil, fmt.Errorf("reader must implement io.Seeker or Size() int64")
+}
+
+// WriteOption is the type used to configure WriteParquet.
+type WriteOption func(*writeOptions)
+
+type writeOptions struct {
+	// Specifies the compression codec
+	compression string
+}
+
+// Compression sets the compression codec used by WriteParquet. The supported
+// codecs are "none", "snappy", "gzip", "brotli" and "zstd".
+func Compression(codec string) WriteOption {
+	return func(c *writeOptions) {
+		c.compression = codec
+	}
+}
+
+// WriteParquet writes the DataFrame to the given io.Writer as a Parquet file.
+// The columns are stored as follows:
+//
+//	String, Categorical  // BYTE_ARRAY (UTF8)
+//	Int, Int64           // INT64
+//	Int32                // INT32
+//	Uint64               // INT64 (unsigned)
+//	Float                // DOUBLE
+//	Float32              // FLOAT
+//	Bool                 // BOOLEAN
+//	Time                 // INT64 (TIMESTAMP, nanoseconds, UTC)
+//
+// Categorical columns are dictionary encoded and read back as String, and Int64
+// columns are read back as Int. All the columns are optional, and missing
+// elements are written as nulls. The data is compressed with snappy unless
+// otherwise specified with Compression.
+func WriteParquet(df dataframe.DataFrame, w io.Writer, options ...WriteOption) error {
+	if df.Err != nil {
+		return df.Err
+	}
+	cfg := writeOptions{
+		compression: "snappy",
+	}
+	for _, option := range options {
+		option(&cfg)
+	}
+	codec, ok := parquetCodecs[cfg.compression]
+	if !ok {
+		return fmt.Errorf("write parquet: unknown compression codec %q", cfg.compression)
+	}
+
+	rec, err := ToRecord(df, nil)
+	if err != nil {
+		return fmt.Errorf("write parquet: %v", err)
+	}
+	defer rec.Release()
+
+	// The Parquet writer closes its destination if it is an io.Closer, which
+	// must be left to the caller.
+	fw, err := pqarrow.NewFileWriter(rec.Schema(), struct{ io.Writer }{w},
+		parquet.NewWriterProperties(parquet.WithCompression(codec)), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return fmt.Errorf("write parquet: %v", err)
+	}
+	if err := fw.Write(rec); err != nil {
+		fw.Close()
+		return fmt.Errorf("write parquet: %v", err)
+	}
+	return fw.Close()
+}
+
+var parquetCodecs = map[string]compress.Compression{
+	"none":   compress.Codecs.Uncompressed,
+	"snappy": compress.Codecs.Snappy,
+	"gzip":   compress.Codecs.Gzip,
+	"brotli": compress.Codecs.Brotli,
+	"zstd":   compress.Codecs.Zstd,
+}
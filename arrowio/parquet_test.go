@@ -0,0 +1,88 @@
+package arrowio
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+func TestWriteParquet(t *testing.T) {
+	a := dataframe.New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+		series.New([]interface{}{"2021-10-10T12:30:00.5Z", "2021-10-11", nil}, series.Time, "E"),
+	)
+	table := []struct {
+		options []dataframe.LoadOption
+		expDf   dataframe.DataFrame
+	}{
+		{
+			nil,
+			a,
+		},
+		{
+			[]dataframe.LoadOption{dataframe.SelectColumns("D", "B")},
+			a.Select([]string{"D", "B"}),
+		},
+		{
+			[]dataframe.LoadOption{
+				dataframe.SelectColumns("B"),
+				dataframe.Names("X"),
+				dataframe.WithTypes(map[string]series.Type{"X": series.String}),
+			},
+			dataframe.New(series.New([]interface{}{"1", "2", nil}, series.String, "X")),
+		},
+	}
+	for _, codec := range []string{"snappy", "none", "zstd"} {
+		buf := new(bytes.Buffer)
+		if err := WriteParquet(a, buf, Compression(codec)); err != nil {
+			t.Fatalf("Codec: %s\nError: %v", codec, err)
+		}
+		for i, tc := range table {
+			b := ReadParquet(bytes.NewReader(buf.Bytes()), tc.options...)
+			if b.Err != nil {
+				t.Errorf("Test: %d\nCodec: %s\nError: %v", i, codec, b.Err)
+				continue
+			}
+			compareFrames(t, i, tc.expDf, b)
+		}
+	}
+
+	if err := WriteParquet(a, new(bytes.Buffer), Compression("lzma")); err == nil {
+		t.Errorf("Expected error on unknown codec")
+	}
+	buf := new(bytes.Buffer)
+	if err := WriteParquet(a, buf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if b := ReadParquet(bytes.NewReader(buf.Bytes()), dataframe.SelectColumns("Z")); b.Err == nil {
+		t.Errorf("Expected error on unknown column")
+	}
+	if b := ReadParquet(bytes.NewReader([]byte("not parquet"))); b.Err == nil {
+		t.Errorf("Expected error on invalid file")
+	}
+}
+
+func compareFrames(t *testing.T, i int, a, b dataframe.DataFrame) {
+	t.Helper()
+	if !reflect.DeepEqual(a.Types(), b.Types()) {
+		t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, a.Types(), b.Types())
+	}
+	if !reflect.DeepEqual(a.Names(), b.Names()) {
+		t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, a.Names(), b.Names())
+	}
+	if !reflect.DeepEqual(a.Records(), b.Records()) {
+		t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, a.Records(), b.Records())
+	}
+	for _, name := range a.Names() {
+		if !reflect.DeepEqual(a.Col(name).IsNull(), b.Col(name).IsNull()) {
+			t.Errorf("Test: %d\nDifferent nulls on %s:\nA:%v\nB:%v", i, name, a.Col(name).IsNull(), b.Col(name).IsNull())
+		}
+	}
+}
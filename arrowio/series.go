@@ -0,0 +1,288 @@
+package arrowio
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/go-gota/gota/series"
+)
+
+// arrowTimestamp is the Arrow type used to store Time Series.
+var arrowTimestamp = &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+
+// arrowCategorical is the Arrow type used to store Categorical Series, with
+// the codes as indices and the categories as dictionary.
+var arrowCategorical = &arrow.DictionaryType{
+	IndexType: arrow.PrimitiveTypes.Int32,
+	ValueType: arrow.BinaryTypes.String,
+	Ordered:   true,
+}
+
+// ArrowType returns the Arrow data type used to store a Series of type t.
+func ArrowType(t series.Type) (arrow.DataType, error) {
+	switch t {
+	case series.String:
+		return arrow.BinaryTypes.String, nil
+	case series.Int:
+		return arrow.PrimitiveTypes.Int64, nil
+	case series.Float:
+		return arrow.PrimitiveTypes.Float64, nil
+	case series.Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case series.Time:
+		return arrowTimestamp, nil
+	case series.Categorical:
+		return arrowCategorical, nil
+	case series.Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case series.Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case series.Uint64:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case series.Float32:
+		return arrow.PrimitiveTypes.Float32, nil
+	}
+	return nil, fmt.Errorf("type %s is not supported", t)
+}
+
+// SeriesToArrow builds an Arrow array with the elements of the Series, using
+// the data type given by ArrowType. Missing elements are stored as Arrow
+// nulls. If mem is nil, memory.DefaultAllocator is used. The caller is
+// responsible for releasing the returned array.
+func SeriesToArrow(s series.Series, mem memory.Allocator) (arrow.Array, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+	dt, err := ArrowType(s.Type())
+	if err != nil {
+		return nil, err
+	}
+	if s.Type() == series.Categorical {
+		return categoricalToArrow(s, mem), nil
+	}
+	b := array.NewBuilder(mem, dt)
+	defer b.Release()
+	b.Reserve(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.Elem(i)
+		if e.IsNull() {
+			b.AppendNull()
+			continue
+		}
+		switch b := b.(type) {
+		case *array.StringBuilder:
+			b.Append(e.String())
+		case *array.Int64Builder:
+			switch v := e.Val().(type) {
+			case int:
+				b.Append(int64(v))
+			case int64:
+				b.Append(v)
+			default:
+				return nil, fmt.Errorf("can't convert %s \"%v\" to int64", e.Type(), e)
+			}
+		case *array.Int32Builder:
+			b.Append(e.Val().(int32))
+		case *array.Uint64Builder:
+			b.Append(e.Val().(uint64))
+		case *array.Float64Builder:
+			b.Append(e.Float())
+		case *array.Float32Builder:
+			b.Append(float32(e.Float()))
+		case *array.BooleanBuilder:
+			v, err := e.Bool()
+			if err != nil {
+				return nil, err
+			}
+			b.Append(v)
+		case *array.TimestampBuilder:
+			v, err := e.Time()
+			if err != nil {
+				return nil, err
+			}
+			b.Append(arrow.Timestamp(v.UnixNano()))
+		}
+	}
+	return b.NewArray(), nil
+}
+
+// categoricalToArrow builds an Arrow dictionary array with the codes and the
+// categories of a Categorical Series.
+func categoricalToArrow(s series.Series, mem memory.Allocator) arrow.Array {
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	codes := s.Codes()
+	ib.Reserve(len(codes))
+	for _, code := range codes {
+		if code < 0 {
+			ib.AppendNull()
+			continue
+		}
+		ib.Append(int32(code))
+	}
+	indices := ib.NewArray()
+	defer indices.Release()
+	vb := array.NewStringBuilder(mem)
+	defer vb.Release()
+	vb.AppendValues(s.Categories(), nil)
+	dict := vb.NewArray()
+	defer dict.Release()
+	return array.NewDictionaryArray(arrowCategorical, indices, dict)
+}
+
+// SeriesFromArrow creates a Series from an Arrow array. Arrow types are mapped
+// as follows:
+//
+//	int8, int16, int64, uint8...uint32  // Int
+//	int32                               // Int32
+//	uint64                              // Uint64
+//	float32                             // Float32
+//	float64                             // Float
+//	bool                                // Bool
+//	string, large_string                // String
+//	timestamp, date32, date64           // Time
+//	dictionary of strings               // Categorical
+//
+// int64 is loaded as Int, the type of the columns written by most tools and by
+// Int Series, so Int64 Series are read back as Int. Arrow nulls are loaded as
+// missing elements. The values of a dictionary are the categories of the
+// Series, as given to series.NewCategorical. The values are copied, so the
+// array can be released once the Series is built.
+func SeriesFromArrow(arr arrow.Array, name string) (series.Series, error) {
+	return fromArrowChunks(arr.DataType(), []arrow.Array{arr}, name)
+}
+
+// SeriesFromArrowChunked creates a Series from the chunks of an Arrow column.
+// See SeriesFromArrow for the supported types.
+func SeriesFromArrowChunked(c *arrow.Chunked, name string) (series.Series, error) {
+	return fromArrowChunks(c.DataType(), c.Chunks(), name)
+}
+
+func fromArrowChunks(dt arrow.DataType, chunks []arrow.Array, name string) (series.Series, error) {
+	if dt.ID() == arrow.DICTIONARY {
+		return fromArrowDictionaries(dt.(*arrow.DictionaryType), chunks, name)
+	}
+	var n int
+	for _, chunk := range chunks {
+		n += chunk.Len()
+	}
+	var t series.Type
+	var value func(arrow.Array, int) interface{}
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT64, arrow.UINT8, arrow.UINT16, arrow.UINT32:
+		t = series.Int
+		value = func(a arrow.Array, i int) interface{} {
+			switch a := a.(type) {
+			case *array.Int8:
+				return int(a.Value(i))
+			case *array.Int16:
+				return int(a.Value(i))
+			case *array.Int64:
+				return int(a.Value(i))
+			case *array.Uint8:
+				return int(a.Value(i))
+			case *array.Uint16:
+				return int(a.Value(i))
+			case *array.Uint32:
+				return int(a.Value(i))
+			}
+			return nil
+		}
+	case arrow.INT32:
+		t = series.Int32
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Int32).Value(i)
+		}
+	case arrow.UINT64:
+		t = series.Uint64
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Uint64).Value(i)
+		}
+	case arrow.FLOAT32:
+		t = series.Float32
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Float32).Value(i)
+		}
+	case arrow.FLOAT64:
+		t = series.Float
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Float64).Value(i)
+		}
+	case arrow.BOOL:
+		t = series.Bool
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Boolean).Value(i)
+		}
+	case arrow.STRING, arrow.LARGE_STRING:
+		t = series.String
+		value = func(a arrow.Array, i int) interface{} {
+			return a.ValueStr(i)
+		}
+	case arrow.TIMESTAMP:
+		t = series.Time
+		unit := dt.(*arrow.TimestampType).Unit
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Timestamp).Value(i).ToTime(unit)
+		}
+	case arrow.DATE32:
+		t = series.Time
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Date32).Value(i).ToTime()
+		}
+	case arrow.DATE64:
+		t = series.Time
+		value = func(a arrow.Array, i int) interface{} {
+			return a.(*array.Date64).Value(i).ToTime()
+		}
+	default:
+		return series.Series{}, fmt.Errorf("series %s: arrow type %s is not supported", name, dt)
+	}
+
+	values := make([]interface{}, 0, n)
+	for _, chunk := range chunks {
+		for i := 0; i < chunk.Len(); i++ {
+			if chunk.IsNull(i) {
+				values = append(values, nil)
+				continue
+			}
+			values = append(values, value(chunk, i))
+		}
+	}
+	return series.New(values, t, name), nil
+}
+
+// fromArrowDictionaries creates a Categorical Series from Arrow dictionary
+// arrays of strings. The categories are the values of the dictionaries in
+// order, with the ones of every chunk added after the ones of the previous
+// chunks.
+func fromArrowDictionaries(dt *arrow.DictionaryType, chunks []arrow.Array, name string) (series.Series, error) {
+	if id := dt.ValueType.ID(); id != arrow.STRING && id != arrow.LARGE_STRING {
+		return series.Series{}, fmt.Errorf("series %s: arrow type %s is not supported", name, dt)
+	}
+	var categories []string
+	seen := make(map[string]bool)
+	var values []interface{}
+	for _, chunk := range chunks {
+		arr := chunk.(*array.Dictionary)
+		dict := arr.Dictionary()
+		for j := 0; j < dict.Len(); j++ {
+			if v := dict.ValueStr(j); dict.IsValid(j) && !seen[v] {
+				seen[v] = true
+				categories = append(categories, v)
+			}
+		}
+		for i := 0; i < arr.Len(); i++ {
+			var v interface{}
+			if j := arr.GetValueIndex(i); arr.IsValid(i) && dict.IsValid(j) {
+				v = dict.ValueStr(j)
+			}
+			values = append(values, v)
+		}
+	}
+	return series.NewCategorical(values, categories, name), nil
+}
@@ -0,0 +1,159 @@
+package arrowio
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/go-gota/gota/series"
+)
+
+func TestSeriesToArrow(t *testing.T) {
+	table := []struct {
+		series series.Series
+	}{
+		{series.New([]interface{}{"a", nil, "c"}, series.String, "A")},
+		{series.New([]interface{}{1, nil, -3}, series.Int, "B")},
+		{series.New([]interface{}{1.5, nil, math.NaN()}, series.Float, "C")},
+		{series.New([]interface{}{nil, true, false}, series.Bool, "D")},
+		{series.New([]interface{}{"2021-10-10T12:30:00.5Z", nil, "2021-10-11"}, series.Time, "E")},
+		{series.New([]int{}, series.Int, "F")},
+		{series.New([]interface{}{int32(-7), nil}, series.Int32, "G")},
+		{series.New([]interface{}{"18446744073709551615", nil, 1}, series.Uint64, "H")},
+		{series.New([]interface{}{float32(0.5), nil}, series.Float32, "I")},
+	}
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	for i, tc := range table {
+		arr, err := SeriesToArrow(tc.series, mem)
+		if err != nil {
+			t.Errorf("Test: %d\nError: %v", i, err)
+			continue
+		}
+		if arr.Len() != tc.series.Len() {
+			t.Errorf("Test: %d\nDifferent length:\nA:%v\nB:%v", i, tc.series.Len(), arr.Len())
+		}
+		b, err := SeriesFromArrow(arr, tc.series.Name)
+		arr.Release()
+		if err != nil {
+			t.Errorf("Test: %d\nError: %v", i, err)
+			continue
+		}
+		if tc.series.Type() != b.Type() || tc.series.Name != b.Name {
+			t.Errorf("Test: %d\nDifferent type or name:\nA:%v %v\nB:%v %v", i, tc.series.Type(), tc.series.Name, b.Type(), b.Name)
+		}
+		if !reflect.DeepEqual(tc.series.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.series.Records(), b.Records())
+		}
+		if !reflect.DeepEqual(tc.series.IsNull(), b.IsNull()) {
+			t.Errorf("Test: %d\nDifferent nulls:\nA:%v\nB:%v", i, tc.series.IsNull(), b.IsNull())
+		}
+	}
+}
+
+func TestSeriesFromArrow(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 2}, []bool{true, false})
+	i32 := ib.NewArray()
+	defer i32.Release()
+
+	fb := array.NewFloat32Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float32{0.5, 2}, nil)
+	f32 := fb.NewArray()
+	defer f32.Release()
+
+	ub := array.NewUint64Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint64{math.MaxUint64, 0}, nil)
+	u64 := ub.NewArray()
+	defer u64.Release()
+
+	sb := array.NewInt16Builder(mem)
+	defer sb.Release()
+	sb.AppendValues([]int16{-3, 4}, nil)
+	i16 := sb.NewArray()
+	defer i16.Release()
+
+	db := array.NewDate32Builder(mem)
+	defer db.Release()
+	db.Append(arrow.Date32FromTime(time.Date(2021, 10, 10, 0, 0, 0, 0, time.UTC)))
+	db.AppendNull()
+	d32 := db.NewArray()
+	defer d32.Release()
+
+	table := []struct {
+		arr      arrow.Array
+		expected series.Series
+	}{
+		{i32, series.New([]interface{}{1, nil}, series.Int32, "x")},
+		{f32, series.New([]float64{0.5, 2}, series.Float32, "x")},
+		{u64, series.New([]string{"18446744073709551615", "0"}, series.Uint64, "x")},
+		{i16, series.New([]int{-3, 4}, series.Int, "x")},
+		{d32, series.New([]interface{}{"2021-10-10", nil}, series.Time, "x")},
+	}
+	for i, tc := range table {
+		b, err := SeriesFromArrow(tc.arr, "x")
+		if err != nil {
+			t.Errorf("Test: %d\nError: %v", i, err)
+			continue
+		}
+		if tc.expected.Type() != b.Type() {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expected.Type(), b.Type())
+		}
+		if !reflect.DeepEqual(tc.expected.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expected.Records(), b.Records())
+		}
+	}
+
+	chunked := arrow.NewChunked(arrow.PrimitiveTypes.Int32, []arrow.Array{i32, i32})
+	defer chunked.Release()
+	b, err := SeriesFromArrowChunked(chunked, "x")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	expected := series.New([]interface{}{1, nil, 1, nil}, series.Int32, "x")
+	if !reflect.DeepEqual(expected.Records(), b.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected.Records(), b.Records())
+	}
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int64)
+	defer lb.Release()
+	list := lb.NewArray()
+	defer list.Release()
+	if _, err := SeriesFromArrow(list, "x"); err == nil {
+		t.Errorf("Expected error on unsupported type")
+	}
+}
+
+func TestSeriesToArrow_Categorical(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	s := series.NewCategorical([]string{"mid", "NaN", "low", "mid"}, []string{"low", "mid", "high"}, "A")
+	arr, err := SeriesToArrow(s, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer arr.Release()
+	got, err := SeriesFromArrow(arr, "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Type() != series.Categorical {
+		t.Errorf("Expected type %v, got %v", series.Categorical, got.Type())
+	}
+	if !reflect.DeepEqual(s.Records(), got.Records()) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", s.Records(), got.Records())
+	}
+	if !reflect.DeepEqual(s.Categories(), got.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", s.Categories(), got.Categories())
+	}
+}
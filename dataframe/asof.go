@@ -0,0 +1,182 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// AsofDirection is the direction used by AsofJoin to search for matches.
+type AsofDirection int
+
+const (
+	// AsofBackward matches the last row of the right DataFrame whose on value
+	// is less than or equal to the one of the left row.
+	AsofBackward AsofDirection = iota
+	// AsofForward matches the first row of the right DataFrame whose on value
+	// is greater than or equal to the one of the left row.
+	AsofForward
+	// AsofNearest matches the row of the right DataFrame whose on value is
+	// closest to the one of the left row, preferring the backward match on
+	// ties.
+	AsofNearest
+)
+
+// Tolerance sets the maximum distance between the on values of the rows matched
+// by AsofJoin, in the units of the on column. For Time columns, see
+// TimeTolerance.
+func Tolerance(d float64) JoinOption {
+	return func(c *joinOptions) {
+		c.tolerance = math.Abs(d)
+		c.hasTolerance = true
+	}
+}
+
+// TimeTolerance sets the maximum distance between the on values of the rows
+// matched by AsofJoin for Time columns.
+func TimeTolerance(d time.Duration) JoinOption {
+	return Tolerance(float64(d))
+}
+
+// AsofJoin returns the left join of two DataFrames matching every row of the
+// DataFrame with the row of b with the nearest value on the column on, in the
+// given direction, instead of an equal one. If by is not empty, only rows with
+// equal values on the by columns are matched, like in Join.
+//
+// The on column must be Int, Float or Time on both DataFrames, and b must be
+// sorted by it in ascending order. The result has all the rows and columns of
+// the DataFrame, in the same order, followed by the columns of b other than
+// on and by, with missing values for the rows without a match. Rows with
+// missing on or by values never match.
+func (df DataFrame) AsofJoin(b DataFrame, on string, by []string, direction AsofDirection, options ...JoinOption) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if b.Err != nil {
+		return b
+	}
+	cfg := joinOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+	if direction != AsofBackward && direction != AsofForward && direction != AsofNearest {
+		return DataFrame{Err: fmt.Errorf("asof join: unknown direction %d", direction)}
+	}
+	iOnA, iOnB := df.colIndex(on), b.colIndex(on)
+	if iOnA < 0 {
+		return DataFrame{Err: fmt.Errorf("asof join: can't find column %q on left DataFrame", on)}
+	}
+	if iOnB < 0 {
+		return DataFrame{Err: fmt.Errorf("asof join: can't find column %q on right DataFrame", on)}
+	}
+	onA, onB := df.columns[iOnA], b.columns[iOnB]
+	switch ta, tb := onA.Type(), onB.Type(); {
+	case ta == series.Time && tb == series.Time:
+	case isNumeric(ta) && isNumeric(tb):
+		if ta != tb {
+			onA = series.New(onA, series.Float, on)
+			onB = series.New(onB, series.Float, on)
+		}
+	default:
+		return DataFrame{Err: fmt.Errorf("asof join: column %q must be numeric or time on both DataFrames", on)}
+	}
+
+	// Group the rows of b by the by columns, keeping them sorted by on
+	var iByA, iByB []int
+	tuplesA := make([]string, df.nrows)
+	tuplesB := make([]string, b.nrows)
+	if len(by) > 0 {
+		var err error
+		iByA, iByB, err = joinKeys(df, b, by)
+		if err != nil {
+			return DataFrame{Err: fmt.Errorf("asof join: %v", err)}
+		}
+		byTypes := make([]series.Type, len(iByA))
+		for k, i := range iByA {
+			byTypes[k] = df.columns[i].Type()
+		}
+		tuplesA = df.keyTuples(iByA, byTypes)
+		tuplesB = b.keyTuples(iByB, byTypes)
+	}
+	groups := make(map[string][]int)
+	var prev series.Element
+	for j, tuple := range tuplesB {
+		e := onB.Elem(j)
+		if e.IsNA() {
+			continue
+		}
+		if prev != nil && e.Less(prev) {
+			return DataFrame{Err: fmt.Errorf("asof join: right DataFrame is not sorted by %q", on)}
+		}
+		prev = e
+		if len(by) > 0 && tuple == "" {
+			continue
+		}
+		groups[tuple] = append(groups[tuple], j)
+	}
+
+	match := make([]int, df.nrows)
+	for i, tuple := range tuplesA {
+		match[i] = -1
+		x := onA.Elem(i)
+		if x.IsNA() || (len(by) > 0 && tuple == "") {
+			continue
+		}
+		rows := groups[tuple]
+		backward, forward := -1, -1
+		if direction != AsofForward {
+			k := sort.Search(len(rows), func(k int) bool {
+				return onB.Elem(rows[k]).Greater(x)
+			})
+			if k > 0 {
+				backward = rows[k-1]
+			}
+		}
+		if direction != AsofBackward {
+			k := sort.Search(len(rows), func(k int) bool {
+				return onB.Elem(rows[k]).GreaterEq(x)
+			})
+			if k < len(rows) {
+				forward = rows[k]
+			}
+		}
+		j := backward
+		switch {
+		case j == -1:
+			j = forward
+		case forward != -1 && asofDistance(x, onB.Elem(forward)) < asofDistance(x, onB.Elem(j)):
+			j = forward
+		}
+		if j != -1 && cfg.hasTolerance && asofDistance(x, onB.Elem(j)) > cfg.tolerance {
+			j = -1
+		}
+		match[i] = j
+	}
+
+	left := make([]series.Series, len(df.columns))
+	for i, col := range df.columns {
+		left[i] = col.Copy()
+	}
+	var right []series.Series
+	for j, col := range b.columns {
+		if j != iOnB && !inIntSlice(j, iByB) {
+			right = append(right, takeRows(col, match, series.Series{}, nil))
+		}
+	}
+	cfg.addSuffixes(left, right)
+	return New(append(left, right...)...)
+}
+
+// asofDistance returns the absolute distance between two non-missing elements
+// of the same type. Time distances are measured in nanoseconds.
+func asofDistance(a, b series.Element) float64 {
+	if a.Type() == series.Time {
+		ta, _ := a.Time()
+		tb, _ := b.Time()
+		return math.Abs(float64(ta.Sub(tb)))
+	}
+	return math.Abs(a.Float() - b.Float())
+}
@@ -0,0 +1,126 @@
+package dataframe
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_AsofJoin(t *testing.T) {
+	a := New(
+		series.New([]interface{}{1, 5, 10, nil, 3}, series.Int, "t"),
+		series.New([]string{"a", "b", "c", "d", "e"}, series.String, "name"),
+	)
+	b := New(
+		series.New([]float64{2, 3, 3, 7}, series.Float, "t"),
+		series.New([]int{20, 30, 31, 70}, series.Int, "value"),
+	)
+	table := []struct {
+		direction AsofDirection
+		options   []JoinOption
+		expected  []interface{}
+	}{
+		{AsofBackward, nil, []interface{}{nil, 31, 70, nil, 31}},
+		{AsofForward, nil, []interface{}{20, 70, nil, nil, 30}},
+		{AsofNearest, nil, []interface{}{20, 31, 70, nil, 31}},
+		{AsofBackward, []JoinOption{Tolerance(2)}, []interface{}{nil, 31, nil, nil, 31}},
+		{AsofNearest, []JoinOption{Tolerance(1)}, []interface{}{20, nil, nil, nil, 31}},
+	}
+	for i, tc := range table {
+		c := a.AsofJoin(b, "t", nil, tc.direction, tc.options...)
+		if c.Err != nil {
+			t.Fatalf("Test: %d\nError: %v", i, c.Err)
+		}
+		expDf := New(
+			series.New([]interface{}{1, 5, 10, nil, 3}, series.Int, "t"),
+			series.New([]string{"a", "b", "c", "d", "e"}, series.String, "name"),
+			series.New(tc.expected, series.Int, "value"),
+		)
+		if !reflect.DeepEqual(expDf.Names(), c.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, expDf.Names(), c.Names())
+		}
+		if !reflect.DeepEqual(expDf.Types(), c.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, expDf.Types(), c.Types())
+		}
+		if !reflect.DeepEqual(expDf.Records(), c.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, expDf.Records(), c.Records())
+		}
+		if exp, got := expDf.Col("value").IsNull(), c.Col("value").IsNull(); !reflect.DeepEqual(exp, got) {
+			t.Errorf("Test: %d\nDifferent nulls:\nA:%v\nB:%v", i, exp, got)
+		}
+	}
+}
+
+func TestDataFrame_AsofJoin_By(t *testing.T) {
+	base := time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)
+	at := func(seconds ...int) []time.Time {
+		times := make([]time.Time, len(seconds))
+		for i, s := range seconds {
+			times[i] = base.Add(time.Duration(s) * time.Second)
+		}
+		return times
+	}
+	trades := New(
+		series.New(at(1, 2, 5, 9), series.Time, "time"),
+		series.New([]string{"MSFT", "GOOG", "MSFT", "AAPL"}, series.String, "ticker"),
+		series.New([]float64{51.95, 720.77, 51.97, 98.0}, series.Float, "price"),
+	)
+	quotes := New(
+		series.New(at(0, 0, 1, 3, 4), series.Time, "time"),
+		series.New([]string{"GOOG", "MSFT", "MSFT", "GOOG", "MSFT"}, series.String, "ticker"),
+		series.New([]float64{720.5, 51.95, 51.97, 720.92, 51.92}, series.Float, "price"),
+	)
+	table := []struct {
+		options  []JoinOption
+		expected []interface{}
+	}{
+		{
+			[]JoinOption{Suffixes("", "_quote")},
+			[]interface{}{51.97, 720.5, 51.92, nil},
+		},
+		{
+			[]JoinOption{Suffixes("", "_quote"), TimeTolerance(time.Second)},
+			[]interface{}{51.97, nil, 51.92, nil},
+		},
+	}
+	for i, tc := range table {
+		c := trades.AsofJoin(quotes, "time", []string{"ticker"}, AsofBackward, tc.options...)
+		if c.Err != nil {
+			t.Fatalf("Test: %d\nError: %v", i, c.Err)
+		}
+		expNames := []string{"time", "ticker", "price", "price_quote"}
+		if !reflect.DeepEqual(expNames, c.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, expNames, c.Names())
+		}
+		exp := series.New(tc.expected, series.Float, "price_quote")
+		if got := c.Col("price_quote"); !reflect.DeepEqual(exp.Records(), got.Records()) ||
+			!reflect.DeepEqual(exp.IsNull(), got.IsNull()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, exp, got)
+		}
+	}
+}
+
+func TestDataFrame_AsofJoin_Errors(t *testing.T) {
+	a := New(
+		series.New([]int{1, 2}, series.Int, "t"),
+		series.New([]string{"a", "b"}, series.String, "s"),
+	)
+	unsorted := New(
+		series.New([]int{3, 1}, series.Int, "t"),
+		series.New([]string{"a", "b"}, series.String, "s"),
+	)
+	table := []DataFrame{
+		a.AsofJoin(unsorted, "t", nil, AsofBackward),
+		a.AsofJoin(a, "s", nil, AsofBackward),
+		a.AsofJoin(a, "x", nil, AsofBackward),
+		a.AsofJoin(a, "t", []string{"x"}, AsofBackward),
+		a.AsofJoin(a, "t", nil, AsofDirection(5)),
+	}
+	for i, df := range table {
+		if df.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package dataframe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/go-gota/gota/series"
+)
+
+// CSVChunkReader reads a CSV file as a sequence of DataFrames of at most a
+// fixed number of rows, so that files larger than memory can be processed one
+// chunk at a time.
+//
+// All the chunks share the same column names and types. The types are taken
+// from the WithTypes option when given, and are otherwise detected from the
+// first chunk. Values of later chunks that can't be parsed as the type of
+// their column are loaded as missing elements, so WithTypes should be used
+// when the first chunk isn't representative of the whole file.
+type CSVChunkReader struct {
+	r         *csv.Reader
+	chunkRows int
+	cfg       loadOptions
+	headers   []string
+	schema    []series.Type
+	pending   []string
+	err       error
+}
+
+// NewCSVChunkReader creates a CSVChunkReader that reads chunks of chunkRows
+// rows from r. It accepts the same options as ReadCSV.
+func NewCSVChunkReader(r io.Reader, chunkRows int, options ...LoadOption) *CSVChunkReader {
+	cfg := defaultLoadOptions()
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	csvReader := csv.NewReader(r)
+	csvReader.Comma = cfg.delimiter
+	csvReader.LazyQuotes = cfg.lazyQuotes
+	csvReader.Comment = cfg.comment
+
+	cr := &CSVChunkReader{
+		r:         csvReader,
+		chunkRows: chunkRows,
+		cfg:       cfg,
+	}
+	if chunkRows <= 0 {
+		cr.err = fmt.Errorf("csv chunk reader: chunk size must be positive: %d", chunkRows)
+	}
+	return cr
+}
+
+// Next reads the next chunk of the file. Once all the rows have been read, it
+// returns io.EOF. Any other error is returned both as the error and in the
+// Err field of the DataFrame, and every later call returns it again.
+func (cr *CSVChunkReader) Next() (DataFrame, error) {
+	if cr.err != nil {
+		return DataFrame{Err: cr.err}, cr.err
+	}
+	if cr.headers == nil {
+		if err := cr.readHeaders(); err != nil {
+			return cr.fail(err)
+		}
+	}
+
+	records := make([][]string, 0, cr.chunkRows)
+	if cr.pending != nil {
+		records = append(records, cr.pending)
+		cr.pending = nil
+	}
+	for len(records) < cr.chunkRows {
+		record, err := cr.r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return cr.fail(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		cr.err = io.EOF
+		return DataFrame{Err: io.EOF}, io.EOF
+	}
+
+	df, failures := loadRecords(cr.headers, records, cr.schema, cr.cfg)
+	if df.Err != nil {
+		return cr.fail(df.Err)
+	}
+	if cr.schema == nil {
+		cr.schema = df.Types()
+	}
+	if df = cr.cfg.conform(df, failures); df.Err != nil {
+		return cr.fail(df.Err)
+	}
+	return df, nil
+}
+
+// Names returns the column names of the chunks. It is empty until the first
+// call to Next.
+func (cr *CSVChunkReader) Names() []string {
+	if cr.headers == nil {
+		return nil
+	}
+	return append([]string(nil), cr.headers...)
+}
+
+// readHeaders sets the column names from the header row or the Names option.
+// Without header, the first record is kept to be loaded with the first chunk.
+func (cr *CSVChunkReader) readHeaders() error {
+	record, err := cr.r.Read()
+	if err == io.EOF {
+		return fmt.Errorf("csv chunk reader: empty file")
+	}
+	if err != nil {
+		return err
+	}
+
+	headers := make([]string, len(record))
+	if cr.cfg.hasHeader {
+		copy(headers, record)
+	} else {
+		cr.pending = record
+	}
+	if cr.cfg.names != nil {
+		if len(cr.cfg.names) != len(record) {
+			if len(cr.cfg.names) > len(record) {
+				return fmt.Errorf("csv chunk reader: too many column names")
+			}
+			return fmt.Errorf("csv chunk reader: not enough column names")
+		}
+		copy(headers, cr.cfg.names)
+	}
+	fixColnames(headers)
+	cr.headers = headers
+	return nil
+}
+
+func (cr *CSVChunkReader) fail(err error) (DataFrame, error) {
+	cr.err = err
+	return DataFrame{Err: err}, err
+}
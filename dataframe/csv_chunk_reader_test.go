@@ -0,0 +1,147 @@
+package dataframe
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestCSVChunkReader(t *testing.T) {
+	csvStr := `A,B,C
+a,1,true
+b,2,false
+c,x,NA
+d,4,true
+e,5,false
+`
+	table := []struct {
+		csv       string
+		chunkRows int
+		options   []LoadOption
+		expDfs    []DataFrame
+	}{
+		{
+			csvStr,
+			2,
+			nil,
+			[]DataFrame{
+				New(
+					series.New([]string{"a", "b"}, series.String, "A"),
+					series.New([]int{1, 2}, series.Int, "B"),
+					series.New([]bool{true, false}, series.Bool, "C"),
+				),
+				New(
+					series.New([]string{"c", "d"}, series.String, "A"),
+					series.New([]interface{}{nil, 4}, series.Int, "B"),
+					series.New([]interface{}{nil, true}, series.Bool, "C"),
+				),
+				New(
+					series.New([]string{"e"}, series.String, "A"),
+					series.New([]int{5}, series.Int, "B"),
+					series.New([]bool{false}, series.Bool, "C"),
+				),
+			},
+		},
+		{
+			csvStr,
+			3,
+			[]LoadOption{WithTypes(map[string]series.Type{"B": series.String})},
+			[]DataFrame{
+				New(
+					series.New([]string{"a", "b", "c"}, series.String, "A"),
+					series.New([]string{"1", "2", "x"}, series.String, "B"),
+					series.New([]interface{}{true, false, nil}, series.Bool, "C"),
+				),
+				New(
+					series.New([]string{"d", "e"}, series.String, "A"),
+					series.New([]string{"4", "5"}, series.String, "B"),
+					series.New([]bool{true, false}, series.Bool, "C"),
+				),
+			},
+		},
+		{
+			"1;2.5\n3;4\n5;6\n",
+			2,
+			[]LoadOption{HasHeader(false), Names("X", "Y"), WithDelimiter(';')},
+			[]DataFrame{
+				New(
+					series.New([]int{1, 3}, series.Int, "X"),
+					series.New([]float64{2.5, 4}, series.Float, "Y"),
+				),
+				New(
+					series.New([]int{5}, series.Int, "X"),
+					series.New([]float64{6}, series.Float, "Y"),
+				),
+			},
+		},
+		{
+			"A,B\n",
+			2,
+			nil,
+			nil,
+		},
+	}
+	for i, tc := range table {
+		cr := NewCSVChunkReader(strings.NewReader(tc.csv), tc.chunkRows, tc.options...)
+		var dfs []DataFrame
+		for {
+			df, err := cr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Test: %d\nError: %v", i, err)
+			}
+			dfs = append(dfs, df)
+		}
+		if len(dfs) != len(tc.expDfs) {
+			t.Errorf("Test: %d\nDifferent number of chunks:\nA:%v\nB:%v", i, len(tc.expDfs), len(dfs))
+			continue
+		}
+		for j, b := range dfs {
+			expDf := tc.expDfs[j]
+			if !reflect.DeepEqual(expDf.Names(), b.Names()) {
+				t.Errorf("Test: %d\nChunk: %d\nDifferent colnames:\nA:%v\nB:%v", i, j, expDf.Names(), b.Names())
+			}
+			if !reflect.DeepEqual(expDf.Types(), b.Types()) {
+				t.Errorf("Test: %d\nChunk: %d\nDifferent types:\nA:%v\nB:%v", i, j, expDf.Types(), b.Types())
+			}
+			if !reflect.DeepEqual(expDf.Records(), b.Records()) {
+				t.Errorf("Test: %d\nChunk: %d\nDifferent values:\nA:%v\nB:%v", i, j, expDf.Records(), b.Records())
+			}
+		}
+		if _, err := cr.Next(); err != io.EOF {
+			t.Errorf("Test: %d\nExpected io.EOF after the last chunk, got: %v", i, err)
+		}
+	}
+}
+
+func TestCSVChunkReader_Errors(t *testing.T) {
+	table := []struct {
+		csv       string
+		chunkRows int
+		options   []LoadOption
+	}{
+		{"A,B\n1,2\n", 0, nil},
+		{"", 2, nil},
+		{"A,B\n1,2\n3\n", 1, nil},
+		{"A,B\n1,2\n", 1, []LoadOption{Names("X")}},
+	}
+	for i, tc := range table {
+		cr := NewCSVChunkReader(strings.NewReader(tc.csv), tc.chunkRows, tc.options...)
+		var err error
+		for err == nil {
+			_, err = cr.Next()
+		}
+		if err == io.EOF {
+			t.Errorf("Test: %d\nExpected error, got io.EOF", i)
+			continue
+		}
+		if df, again := cr.Next(); again != err || df.Err != err {
+			t.Errorf("Test: %d\nExpected the same error on later calls:\nA:%v\nB:%v", i, err, again)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package dataframe
 
 import (
+	"bufio"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/go-gota/gota/series"
@@ -35,6 +37,9 @@ type DataFrame struct {
 	ncols   int
 	nrows   int
 
+	// The names of the index columns, if any. See SetIndex.
+	index []string
+
 	// deprecated: Use Error() instead
 	Err error
 }
@@ -97,6 +102,7 @@ func (df DataFrame) Copy() DataFrame {
 	if df.Err != nil {
 		copy.Err = df.Err
 	}
+	copy.index = df.index
 	return copy
 }
 
@@ -300,6 +306,7 @@ func (df DataFrame) Subset(indexes series.Indexes) DataFrame {
 		columns: columns,
 		ncols:   ncols,
 		nrows:   nrows,
+		index:   df.index,
 	}
 }
 
@@ -315,7 +322,8 @@ func (df DataFrame) Subset(indexes series.Indexes) DataFrame {
 //     Series [String]  // Same as []string
 type SelectIndexes interface{}
 
-// Select the given DataFrame columns
+// Select the given DataFrame columns. The index is kept if all of its columns
+// are selected.
 func (df DataFrame) Select(indexes SelectIndexes) DataFrame {
 	if df.Err != nil {
 		return df
@@ -339,16 +347,18 @@ func (df DataFrame) Select(indexes SelectIndexes) DataFrame {
 		columns: columns,
 		ncols:   ncols,
 		nrows:   nrows,
+		index:   df.index,
 	}
 	colnames := df.Names()
 	fixColnames(colnames)
 	for i, colname := range colnames {
 		df.columns[i].Name = colname
 	}
-	return df
+	return df.checkIndex()
 }
 
-// Drop the given DataFrame columns
+// Drop the given DataFrame columns. The index is kept unless one of its columns
+// is dropped.
 func (df DataFrame) Drop(indexes SelectIndexes) DataFrame {
 	if df.Err != nil {
 		return df
@@ -371,67 +381,145 @@ func (df DataFrame) Drop(indexes SelectIndexes) DataFrame {
 		columns: columns,
 		ncols:   ncols,
 		nrows:   nrows,
+		index:   df.index,
 	}
 	colnames := df.Names()
 	fixColnames(colnames)
 	for i, colname := range colnames {
 		df.columns[i].Name = colname
 	}
-	return df
+	return df.checkIndex()
 }
 
 const KEY_ERROR = "KEY_ERROR"
 
-//GroupBy Group dataframe by columns
+//GroupBy Group dataframe by columns. The groups are kept in order of first
+// appearance of their keys, which can be changed with Groups.SortByKey. Rows
+// with missing values on the grouping columns form groups of their own.
 func (df DataFrame) GroupBy(colnames ...string) *Groups {
 	if len(colnames) <= 0 {
 		return nil
 	}
-	groupDataFrame := make(map[string]DataFrame)
-	groupSeries := make(map[string][]map[string]interface{})
+	if df.Err != nil {
+		return &Groups{Err: df.Err}
+	}
 	// Check that colname exist on dataframe
-	for _, c := range colnames {
-		if idx := findInStringSlice(c, df.Names()); idx == -1 {
+	colidx := make([]int, len(colnames))
+	for i, c := range colnames {
+		idx := findInStringSlice(c, df.Names())
+		if idx == -1 {
 			return &Groups{Err: fmt.Errorf("GroupBy: can't find column name: %s", c)}
 		}
+		colidx[i] = idx
 	}
 
-	for _, s := range df.Maps() {
-		// Gen Key for per Series
-		key := ""
-		for i, c := range colnames {
-			format := ""
-			if i == 0 {
-				format = "%s%"
-			} else {
-				format = "%s_%"
-			}
-			switch s[c].(type) {
-			case string, bool:
-				format += "s"
-			case int, int16, int32, int64:
-				format += "d"
-			case float32, float64:
-				format += "f"
-			default:
-				return &Groups{Err: fmt.Errorf("GroupBy: type not found")}
+	var keys []groupKey
+	var rows [][]int
+	lookup := make(map[string]int)
+	ids := make(map[string]bool)
+	encoders := make([]func(int) string, len(colidx))
+	for j, idx := range colidx {
+		encoders[j] = tupleEncoder(df.columns[idx])
+	}
+	var b strings.Builder
+	for i := 0; i < df.nrows; i++ {
+		b.Reset()
+		for _, encode := range encoders {
+			b.WriteString(encode(i))
+		}
+		tuple := b.String()
+		g, ok := lookup[tuple]
+		if !ok {
+			values := make([]series.Element, len(colidx))
+			for j, idx := range colidx {
+				values[j] = df.columns[idx].Elem(i).Copy()
 			}
-			key = fmt.Sprintf(format, key, s[c])
+			g = len(keys)
+			lookup[tuple] = g
+			keys = append(keys, groupKey{id: groupID(values, ids), values: values})
+			rows = append(rows, nil)
 		}
-		groupSeries[key] = append(groupSeries[key], s)
+		rows[g] = append(rows[g], i)
 	}
 
-	// Save column types
-	colTypes := map[string]series.Type{}
-	for _, c := range df.columns {
-		colTypes[c.Name] = c.Type()
+	groupDataFrame := make(map[string]DataFrame, len(keys))
+	for g := range keys {
+		keys[g].rows = rows[g]
+		groupDataFrame[keys[g].id] = df.Subset(rows[g])
 	}
+	return &Groups{groups: groupDataFrame, colnames: colnames, keys: keys, df: df}
+}
 
-	for k, cMaps := range groupSeries {
-		groupDataFrame[k] = LoadMaps(cMaps, WithTypes(colTypes))
+// groupTuple encodes the values of a group key as a string that is unique to
+// them, to be used as map key.
+func groupTuple(values []series.Element) string {
+	var b strings.Builder
+	for _, e := range values {
+		if e.IsNull() {
+			b.WriteString("null,")
+			continue
+		}
+		var v string
+		switch e.Type() {
+		case series.Float, series.Float32:
+			v = strconv.FormatFloat(e.Float(), 'g', -1, 64)
+		default:
+			v = e.String()
+		}
+		b.WriteString(strconv.Quote(v))
+		b.WriteByte(',')
 	}
-	groups := &Groups{groups: groupDataFrame, colnames: colnames}
-	return groups
+	return b.String()
+}
+
+// tupleEncoder returns a function that encodes the i-th element of col as
+// groupTuple does. The categories of Categorical columns are encoded only once.
+func tupleEncoder(col series.Series) func(i int) string {
+	if col.Type() == series.Categorical {
+		categories := col.Categories()
+		encoded := make([]string, len(categories))
+		for c, v := range categories {
+			encoded[c] = strconv.Quote(v) + ","
+		}
+		codes := col.Codes()
+		return func(i int) string {
+			if codes[i] < 0 {
+				return "null,"
+			}
+			return encoded[codes[i]]
+		}
+	}
+	values := make([]series.Element, 1)
+	return func(i int) string {
+		values[0] = col.Elem(i)
+		return groupTuple(values)
+	}
+}
+
+// groupID returns the id of a group in the map returned by Groups.GetGroups,
+// which joins the key values with "_". If it is already taken by another group
+// in ids, a numeric suffix is added.
+func groupID(values []series.Element, ids map[string]bool) string {
+	parts := make([]string, len(values))
+	for i, e := range values {
+		switch {
+		case e.IsNull():
+			parts[i] = "NaN"
+		case e.Type() == series.Int:
+			v, _ := e.Int()
+			parts[i] = fmt.Sprintf("%d", v)
+		case e.Type() == series.Float:
+			parts[i] = fmt.Sprintf("%f", e.Float())
+		default:
+			parts[i] = e.String()
+		}
+	}
+	id := strings.Join(parts, "_")
+	for n := 1; ids[id]; n++ {
+		id = fmt.Sprintf("%s_%d", strings.Join(parts, "_"), n)
+	}
+	ids[id] = true
+	return id
 }
 
 //AggregationType Aggregation method type
@@ -452,11 +540,26 @@ const (
 type Groups struct {
 	groups      map[string]DataFrame
 	colnames    []string
+	keys        []groupKey
 	aggregation DataFrame
-	Err         error
+	// The grouped DataFrame, used to align the results of Transform.
+	df  DataFrame
+	Err error
 }
 
-// Aggregation :Aggregate dataframe by aggregation type and aggregation column name
+// groupKey holds the values of the grouping columns for one of the groups, in
+// the same order as Groups.colnames, and the rows of the grouped DataFrame that
+// belong to it. The order of Groups.keys is the order of the groups.
+type groupKey struct {
+	id     string
+	values []series.Element
+	rows   []int
+}
+
+// Aggregation :Aggregate dataframe by aggregation type and aggregation column name.
+// The grouping columns come first, keeping their types, followed by the
+// aggregated columns in the given order. There is one row per group, in the
+// order of the groups.
 func (gps Groups) Aggregation(typs []AggregationType, colnames []string) DataFrame {
 	if gps.groups == nil {
 		return DataFrame{Err: fmt.Errorf("Aggregation: input is nil")}
@@ -464,64 +567,45 @@ func (gps Groups) Aggregation(typs []AggregationType, colnames []string) DataFra
 	if len(typs) != len(colnames) {
 		return DataFrame{Err: fmt.Errorf("Aggregation: len(typs) != len(colanmes)")}
 	}
-	dfMaps := make([]map[string]interface{}, 0)
-	for _, df := range gps.groups {
-		targetMap := df.Maps()[0]
-		curMap := make(map[string]interface{})
-		// add columns of  group by
-		for _, c := range gps.colnames {
-			if value, ok := targetMap[c]; ok {
-				curMap[c] = value
-			} else {
+	columns := gps.keyColumns()
+	for k, c := range colnames {
+		values := make([]float64, len(gps.keys))
+		for i, key := range gps.keys {
+			curSeries := gps.groups[key.id].Col(c)
+			if curSeries.Err != nil {
 				return DataFrame{Err: fmt.Errorf("Aggregation: can't find column name: %s", c)}
 			}
-		}
-		// Aggregation
-		for i, c := range colnames {
-			curSeries := df.Col(c)
-			var value float64
-			switch typs[i] {
-			case Aggregation_MAX:
-				value = curSeries.Max()
-			case Aggregation_MEAN:
-				value = curSeries.Mean()
-			case Aggregation_MEDIAN:
-				value = curSeries.Median()
-			case Aggregation_MIN:
-				value = curSeries.Min()
-			case Aggregation_STD:
-				value = curSeries.StdDev()
-			case Aggregation_SUM:
-				value = curSeries.Sum()
-			case Aggregation_COUNT:
-				value = float64(curSeries.Len())
-			default:
-				return DataFrame{Err: fmt.Errorf("Aggregation: this method %s not found", typs[i])}
-
+			value, err := aggregate(curSeries, typs[k])
+			if err != nil {
+				return DataFrame{Err: err}
 			}
-			curMap[fmt.Sprintf("%s_%s", c, typs[i])] = value
+			values[i] = value
 		}
-		dfMaps = append(dfMaps, curMap)
-
+		columns = append(columns, series.New(values, series.Float, fmt.Sprintf("%s_%s", c, typs[k])))
 	}
+	gps.aggregation = New(columns...)
+	return gps.aggregation
+}
 
-	// Save column types
-	colTypes := map[string]series.Type{}
-	for k := range dfMaps[0] {
-		switch dfMaps[0][k].(type) {
-		case string:
-			colTypes[k] = series.String
-		case int, int16, int32, int64:
-			colTypes[k] = series.Int
-		case float32, float64:
-			colTypes[k] = series.Float
-		default:
-			continue
-		}
+// aggregate reduces a Series to a single value with the given AggregationType.
+func aggregate(s series.Series, typ AggregationType) (float64, error) {
+	switch typ {
+	case Aggregation_MAX:
+		return s.Max(), nil
+	case Aggregation_MEAN:
+		return s.Mean(), nil
+	case Aggregation_MEDIAN:
+		return s.Median(), nil
+	case Aggregation_MIN:
+		return s.Min(), nil
+	case Aggregation_STD:
+		return s.StdDev(), nil
+	case Aggregation_SUM:
+		return s.Sum(), nil
+	case Aggregation_COUNT:
+		return float64(s.Len()), nil
 	}
-
-	gps.aggregation = LoadMaps(dfMaps, WithTypes(colTypes))
-	return gps.aggregation
+	return 0, fmt.Errorf("Aggregation: this method %s not found", typ)
 }
 
 // GetGroups returns the grouped data frames created by GroupBy
@@ -543,10 +627,17 @@ func (df DataFrame) Rename(newname, oldname string) DataFrame {
 
 	copy := df.Copy()
 	copy.columns[idx].Name = newname
+	if i := findInStringSlice(oldname, df.index); i != -1 {
+		copy.index = append([]string(nil), df.index...)
+		copy.index[i] = newname
+	}
 	return copy
 }
 
-// CBind combines the columns of this DataFrame and dfb DataFrame.
+// CBind combines the columns of this DataFrame and dfb DataFrame. If both
+// DataFrames have an index, the rows of dfb are aligned to the index labels of
+// this DataFrame: labels missing from dfb get missing values and rows of dfb
+// with other labels are dropped.
 func (df DataFrame) CBind(dfb DataFrame) DataFrame {
 	if df.Err != nil {
 		return df
@@ -554,12 +645,17 @@ func (df DataFrame) CBind(dfb DataFrame) DataFrame {
 	if dfb.Err != nil {
 		return dfb
 	}
+	if df.index != nil && dfb.index != nil {
+		return df.alignedCBind(dfb)
+	}
 	cols := append(df.columns, dfb.columns...)
 	return New(cols...)
 }
 
 // RBind matches the column names of two DataFrames and returns combined
-// rows from both of them.
+// rows from both of them. If both DataFrames are indexed, their indexes must
+// have the same columns and no label in common, and the result keeps the
+// index; otherwise it has no index.
 func (df DataFrame) RBind(dfb DataFrame) DataFrame {
 	if df.Err != nil {
 		return df
@@ -567,6 +663,11 @@ func (df DataFrame) RBind(dfb DataFrame) DataFrame {
 	if dfb.Err != nil {
 		return dfb
 	}
+	if df.index != nil && dfb.index != nil {
+		if err := df.checkIndexOverlap(dfb); err != nil {
+			return DataFrame{Err: fmt.Errorf("rbind: %v", err)}
+		}
+	}
 	expandedSeries := make([]series.Series, df.ncols)
 	for k, v := range df.Names() {
 		idx := findInStringSlice(v, dfb.Names())
@@ -582,7 +683,11 @@ func (df DataFrame) RBind(dfb DataFrame) DataFrame {
 		}
 		expandedSeries[k] = newSeries
 	}
-	return New(expandedSeries...)
+	res := New(expandedSeries...)
+	if res.Err == nil && df.index != nil && dfb.index != nil {
+		res.index = df.index
+	}
+	return res
 }
 
 // Concat concatenates rows of two DataFrames like RBind, but also including
@@ -639,6 +744,9 @@ func (df DataFrame) Mutate(s series.Series) DataFrame {
 	if df.Err != nil {
 		return df
 	}
+	if s.Err != nil {
+		return DataFrame{Err: fmt.Errorf("mutate: %v", s.Err)}
+	}
 	if s.Len() != df.nrows {
 		return DataFrame{Err: fmt.Errorf("mutate: wrong dimensions")}
 	}
@@ -658,16 +766,17 @@ func (df DataFrame) Mutate(s series.Series) DataFrame {
 		columns: columns,
 		ncols:   ncols,
 		nrows:   nrows,
+		index:   df.index,
 	}
 	colnames := df.Names()
 	fixColnames(colnames)
 	for i, colname := range colnames {
 		df.columns[i].Name = colname
 	}
-	return df
+	return df.checkIndex()
 }
 
-// F is the filtering structure
+// F is the filtering structure. Filters on expressions are built with Expr.
 type F struct {
 	Colidx     int
 	Colname    string
@@ -713,6 +822,15 @@ func (df DataFrame) FilterAggregation(agg Aggregation, filters ...F) DataFrame {
 
 	compResults := make([]series.Series, len(filters))
 	for i, f := range filters {
+		if f.Comparator == exprComparator {
+			expr, _ := f.Comparando.(string)
+			res, err := filterExpr(df, expr)
+			if err != nil {
+				return DataFrame{Err: fmt.Errorf("filter: %v", err)}
+			}
+			compResults[i] = res
+			continue
+		}
 		var idx int
 		if f.Colname == "" {
 			idx = f.Colidx
@@ -839,22 +957,28 @@ func (df DataFrame) Rapply(f func(series.Series) series.Series) DataFrame {
 	}
 
 	detectType := func(types []series.Type) series.Type {
-		var hasStrings, hasFloats, hasInts, hasBools bool
+		var hasStrings, hasFloats, hasInts, hasBools, hasTimes bool
 		for _, t := range types {
 			switch t {
-			case series.String:
+			case series.String, series.Categorical:
 				hasStrings = true
-			case series.Float:
+			case series.Float, series.Float32:
 				hasFloats = true
-			case series.Int:
+			case series.Int, series.Int64, series.Int32, series.Uint64:
 				hasInts = true
 			case series.Bool:
 				hasBools = true
+			case series.Time:
+				hasTimes = true
 			}
 		}
 		switch {
 		case hasStrings:
 			return series.String
+		case hasTimes && (hasBools || hasFloats || hasInts):
+			return series.String
+		case hasTimes:
+			return series.Time
 		case hasBools:
 			return series.Bool
 		case hasFloats:
@@ -948,7 +1072,8 @@ type loadOptions struct {
 	// The names to set as columns names.
 	names []string
 
-	// Defines which values are going to be considered as NaN when parsing from string.
+	// Defines which values are going to be considered as missing when parsing
+	// from string.
 	nanValues []string
 
 	// Defines the csv delimiter
@@ -962,6 +1087,29 @@ type loadOptions struct {
 
 	// The types of specific columns can be specified via column name.
 	types map[string]series.Type
+
+	// Defines the layout used to parse Time columns from string. If empty, the
+	// layouts supported by series.ParseTime are used.
+	timeLayout string
+
+	// Specifies whether Time columns are detected when detectTypes is set.
+	detectTimes bool
+
+	// The names of the columns to load, for formats that support reading a
+	// subset of the columns.
+	columns []string
+
+	// If set, nested JSON objects are expanded into columns named by joining
+	// their keys with flattenSep, up to flattenDepth levels (all if zero).
+	flatten      bool
+	flattenSep   string
+	flattenDepth int
+
+	// If set, JSON arrays are exploded into a row per element.
+	explodeArrays bool
+
+	// If set, the loaded DataFrame is validated against it.
+	schema Schema
 }
 
 // DefaultType sets the defaultType option for loadOptions.
@@ -1006,6 +1154,58 @@ func WithTypes(coltypes map[string]series.Type) LoadOption {
 	}
 }
 
+// WithTimeLayout sets the layout used for parsing and detecting Time columns,
+// for example "02/01/2006 15:04". Columns with values in this layout are
+// detected as Time even without DetectTimes.
+func WithTimeLayout(layout string) LoadOption {
+	return func(c *loadOptions) {
+		c.timeLayout = layout
+	}
+}
+
+// DetectTimes sets the detectTimes option for loadOptions. If set, columns
+// with values in RFC 3339 or "2006-01-02" format are detected as Time instead
+// of String.
+func DetectTimes(b bool) LoadOption {
+	return func(c *loadOptions) {
+		c.detectTimes = b
+	}
+}
+
+// SelectColumns sets the columns option for loadOptions. Only the given
+// columns are read, in the given order, from formats that support it such as
+// Parquet.
+func SelectColumns(names ...string) LoadOption {
+	return func(c *loadOptions) {
+		c.columns = names
+	}
+}
+
+// FlattenNested sets the flatten options for loadOptions. Nested objects of
+// JSON inputs and LoadMaps are expanded into a column per key, named by
+// joining the keys of the path with sep, as "parent.child" for ".". Objects
+// nested more than maxDepth levels are kept as JSON strings, unless maxDepth is
+// zero or negative. Loading fails if a flattened name is already a key of the
+// row, as "a.b" in {"a.b": 1, "a": {"b": 2}}.
+func FlattenNested(sep string, maxDepth int) LoadOption {
+	return func(c *loadOptions) {
+		c.flatten = true
+		c.flattenSep = sep
+		c.flattenDepth = maxDepth
+	}
+}
+
+// ExplodeArrays sets the explodeArrays option for loadOptions. Arrays of JSON
+// inputs and LoadMaps are exploded into a row per element, repeating the other
+// values of the row. Several arrays of the same object are exploded together,
+// element by element, and loading fails if their lengths differ. Otherwise
+// they are kept as JSON strings.
+func ExplodeArrays(b bool) LoadOption {
+	return func(c *loadOptions) {
+		c.explodeArrays = b
+	}
+}
+
 // WithDelimiter sets the csv delimiter other than ',', for example '\t'
 func WithDelimiter(b rune) LoadOption {
 	return func(c *loadOptions) {
@@ -1065,12 +1265,7 @@ func LoadStructs(i interface{}, options ...LoadOption) DataFrame {
 	}
 
 	// Set the default load options
-	cfg := loadOptions{
-		defaultType: series.String,
-		detectTypes: true,
-		hasHeader:   true,
-		nanValues:   []string{"NA", "NaN", "<nil>"},
-	}
+	cfg := defaultLoadOptions()
 
 	// Set any custom load options
 	for _, option := range options {
@@ -1121,7 +1316,7 @@ func LoadStructs(i interface{}, options ...LoadOption) DataFrame {
 
 			// Handle `types` option
 			var t series.Type
-			if cfgtype, ok := cfg.types[fieldName]; ok {
+			if cfgtype, ok := cfg.columnType(fieldName); ok {
 				t = cfgtype
 			} else {
 				// Handle `detectTypes` option
@@ -1158,7 +1353,7 @@ func LoadStructs(i interface{}, options ...LoadOption) DataFrame {
 			}
 			columns = append(columns, series.New(elements, t, fieldName))
 		}
-		return New(columns...)
+		return cfg.conform(New(columns...), nil)
 	}
 	return DataFrame{Err: fmt.Errorf(
 		"load: type %s (%s) is not supported, must be []struct", tpy.Name(), tpy.Kind())}
@@ -1166,27 +1361,49 @@ func LoadStructs(i interface{}, options ...LoadOption) DataFrame {
 
 func parseType(s string) (series.Type, error) {
 	switch s {
-	case "float", "float64", "float32":
+	case "float", "float64":
 		return series.Float, nil
-	case "int", "int64", "int32", "int16", "int8":
+	case "float32":
+		return series.Float32, nil
+	case "int", "int16", "int8", "uint8", "uint16", "uint32":
 		return series.Int, nil
+	case "int64":
+		return series.Int64, nil
+	case "int32":
+		return series.Int32, nil
+	case "uint64", "uint":
+		return series.Uint64, nil
 	case "string":
 		return series.String, nil
 	case "bool":
 		return series.Bool, nil
+	case "time", "time.Time":
+		return series.Time, nil
+	case "categorical", "category":
+		return series.Categorical, nil
 	}
 	return "", fmt.Errorf("type (%s) is not supported", s)
 }
 
-// LoadRecords creates a new DataFrame based on the given records.
-func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
-	// Set the default load options
-	cfg := loadOptions{
+// defaultLoadOptions returns the load options used by all the loaders unless
+// otherwise specified.
+func defaultLoadOptions() loadOptions {
+	return loadOptions{
 		defaultType: series.String,
 		detectTypes: true,
 		hasHeader:   true,
 		nanValues:   []string{"NA", "NaN", "<nil>"},
+		delimiter:   ',',
 	}
+}
+
+// LoadRecords creates a new DataFrame based on the given records. Unless
+// otherwise specified with NaNValues, the "NA", "NaN" and "<nil>" values are
+// loaded as missing elements. Empty strings are only missing if given to
+// NaNValues.
+func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
+	// Set the default load options
+	cfg := defaultLoadOptions()
 
 	// Set any custom load options
 	for _, option := range options {
@@ -1215,7 +1432,14 @@ func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
 	if cfg.names != nil {
 		headers = cfg.names
 	}
+	return cfg.conform(loadRecords(headers, records, nil, cfg))
+}
 
+// loadRecords builds a DataFrame from records without header. If schema is not
+// nil, it fixes the type of every column, otherwise the types are taken from
+// the load options or detected from the records. With the schema option, it
+// also returns the values that couldn't be parsed, to be given to conform.
+func loadRecords(headers []string, records [][]string, schema []series.Type, cfg loadOptions) (DataFrame, []Violation) {
 	types := make([]series.Type, len(headers))
 	rawcols := make([][]string, len(headers))
 	for i, colname := range headers {
@@ -1228,11 +1452,15 @@ func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
 		}
 		rawcols[i] = rawcol
 
-		t, ok := cfg.types[colname]
+		if schema != nil {
+			types[i] = schema[i]
+			continue
+		}
+		t, ok := cfg.columnType(colname)
 		if !ok {
 			t = cfg.defaultType
 			if cfg.detectTypes {
-				if l, err := findType(rawcol); err == nil {
+				if l, err := findType(rawcol, cfg.detectTimes || cfg.timeLayout != "", cfg.timeLayout); err == nil {
 					t = l
 				}
 			}
@@ -1242,15 +1470,25 @@ func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
 
 	columns := make([]series.Series, len(headers))
 	for i, colname := range headers {
-		col := series.New(rawcols[i], types[i], colname)
+		var values interface{} = rawcols[i]
+		if types[i] == series.Time && cfg.timeLayout != "" {
+			times := make([]interface{}, len(rawcols[i]))
+			for j, str := range rawcols[i] {
+				if t, err := time.Parse(cfg.timeLayout, str); err == nil {
+					times[j] = t
+				}
+			}
+			values = times
+		}
+		col := series.New(values, types[i], colname)
 		if col.Err != nil {
-			return DataFrame{Err: col.Err}
+			return DataFrame{Err: col.Err}, nil
 		}
 		columns[i] = col
 	}
 	nrows, ncols, err := checkColumnsDimensions(columns...)
 	if err != nil {
-		return DataFrame{Err: err}
+		return DataFrame{Err: err}, nil
 	}
 	df := DataFrame{
 		columns: columns,
@@ -1263,7 +1501,19 @@ func LoadRecords(records [][]string, options ...LoadOption) DataFrame {
 	for i, colname := range colnames {
 		df.columns[i].Name = colname
 	}
-	return df
+
+	var failures []Violation
+	if cfg.schema != nil {
+		for i, col := range df.columns {
+			// Empty fields are missing, not unparseable, also when they
+			// aren't NaNValues
+			present := func(j int) bool { return rawcols[i][j] != "NaN" && rawcols[i][j] != "" }
+			if v, ok := parseFailures(col, present); ok {
+				failures = append(failures, v)
+			}
+		}
+	}
+	return df, failures
 }
 
 // LoadMaps creates a new DataFrame based on the given maps. This function assumes
@@ -1290,21 +1540,7 @@ func LoadMaps(maps []map[string]interface{}, options ...LoadOption) DataFrame {
 		}
 	}
 	sort.Strings(colnames)
-	records := make([][]string, len(maps)+1)
-	records[0] = colnames
-	for k, m := range maps {
-		row := make([]string, len(colnames))
-		for i, colname := range colnames {
-			element := ""
-			val, ok := m[colname]
-			if ok {
-				element = fmt.Sprint(val)
-			}
-			row[i] = element
-		}
-		records[k+1] = row
-	}
-	return LoadRecords(records, options...)
+	return loadJSONRows(colnames, maps, options...)
 }
 
 // LoadMatrix loads the given Matrix as a DataFrame
@@ -1336,15 +1572,66 @@ func LoadMatrix(mat Matrix) DataFrame {
 	return df
 }
 
+// LoadColumns creates a new DataFrame from typed columns read from another
+// source, as a Parquet or Arrow file. names are the columns of the source, and
+// load is called once with the positions of the columns to read, in the order
+// given by SelectColumns, which it must return as Series. The Names, WithTypes
+// and WithSchema options are then applied to the returned columns.
+func LoadColumns(names []string, load func(indices []int) ([]series.Series, error), options ...LoadOption) DataFrame {
+	cfg := loadOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	var indices []int
+	if cfg.columns == nil {
+		for i := range names {
+			indices = append(indices, i)
+		}
+	} else {
+		for _, name := range cfg.columns {
+			i := findInStringSlice(name, names)
+			if i < 0 {
+				return DataFrame{Err: fmt.Errorf("load: can't find column name: %s", name)}
+			}
+			indices = append(indices, i)
+		}
+	}
+	ncols := len(indices)
+	if cfg.names != nil && len(cfg.names) != ncols {
+		if len(cfg.names) > ncols {
+			return DataFrame{Err: fmt.Errorf("load: too many column names")}
+		}
+		return DataFrame{Err: fmt.Errorf("load: not enough column names")}
+	}
+
+	columns, err := load(indices)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("load: %v", err)}
+	}
+	if len(columns) != ncols {
+		return DataFrame{Err: fmt.Errorf("load: expected %d columns, got %d", ncols, len(columns))}
+	}
+	for i, col := range columns {
+		if cfg.names != nil {
+			col.Name = cfg.names[i]
+		}
+		if t, ok := cfg.columnType(col.Name); ok && t != col.Type() {
+			col = series.New(col, t, col.Name)
+		}
+		columns[i] = col
+	}
+	if ncols == 0 {
+		return DataFrame{}
+	}
+	return cfg.conform(New(columns...), nil)
+}
+
 // ReadCSV reads a CSV file from a io.Reader and builds a DataFrame with the
 // resulting records.
 func ReadCSV(r io.Reader, options ...LoadOption) DataFrame {
 	csvReader := csv.NewReader(r)
-	cfg := loadOptions{
-		delimiter:  ',',
-		lazyQuotes: false,
-		comment:    0,
-	}
+	cfg := defaultLoadOptions()
 	for _, option := range options {
 		option(&cfg)
 	}
@@ -1360,17 +1647,24 @@ func ReadCSV(r io.Reader, options ...LoadOption) DataFrame {
 	return LoadRecords(records, options...)
 }
 
-// ReadJSON reads a JSON array from a io.Reader and builds a DataFrame with the
-// resulting records.
+// ReadJSON reads JSON from a io.Reader and builds a DataFrame with the
+// resulting records. The input can be either an array of objects, one per row,
+// or an object of arrays, one per column. Columns keep the order in which
+// their keys first appear.
 func ReadJSON(r io.Reader, options ...LoadOption) DataFrame {
-	var m []map[string]interface{}
 	d := json.NewDecoder(r)
 	d.UseNumber()
-	err := d.Decode(&m)
+	tok, err := d.Token()
 	if err != nil {
 		return DataFrame{Err: err}
 	}
-	return LoadMaps(m, options...)
+	switch tok {
+	case json.Delim('['):
+		return readJSONRecords(d, options...)
+	case json.Delim('{'):
+		return readJSONColumns(d, options...)
+	}
+	return DataFrame{Err: fmt.Errorf("read json: expected array or object, got %v", tok)}
 }
 
 // WriteOption is the type used to configure the writing of elements
@@ -1379,6 +1673,24 @@ type WriteOption func(*writeOptions)
 type writeOptions struct {
 	// Specifies whether the header is also written
 	writeHeader bool
+
+	// Specifies the string written in place of missing elements
+	nullValue string
+
+	// Specifies what WriteSQL does with the destination table
+	sqlMode SQLWriteMode
+
+	// Specifies the number of rows inserted by every SQL statement
+	batchSize int
+
+	// Returns the placeholder for the n-th parameter of a SQL statement
+	placeholder func(n int) string
+
+	// Quotes the table and column names of SQL statements
+	quote func(name string) string
+
+	// Specifies the layout of the JSON written by WriteJSON
+	orient JSONOrient
 }
 
 // WriteHeader sets the writeHeader option for writeOptions.
@@ -1388,7 +1700,18 @@ func WriteHeader(b bool) WriteOption {
 	}
 }
 
-// WriteCSV writes the DataFrame to the given io.Writer as a CSV file.
+// NullValue sets the nullValue option for writeOptions.
+func NullValue(s string) WriteOption {
+	return func(c *writeOptions) {
+		c.nullValue = s
+	}
+}
+
+// WriteCSV writes the DataFrame to the given io.Writer as a CSV file. Missing
+// elements are written as empty fields unless otherwise specified with
+// NullValue, and NaN values as "nan", so that ReadCSV loads them back as
+// missing and NaN in every column but the String ones, where empty fields are
+// only missing if "" is given to NaNValues.
 func (df DataFrame) WriteCSV(w io.Writer, options ...WriteOption) error {
 	if df.Err != nil {
 		return df.Err
@@ -1405,6 +1728,13 @@ func (df DataFrame) WriteCSV(w io.Writer, options ...WriteOption) error {
 	}
 
 	records := df.Records()
+	for j, col := range df.columns {
+		for i := 0; i < df.nrows; i++ {
+			if col.Elem(i).IsNull() {
+				records[i+1][j] = cfg.nullValue
+			}
+		}
+	}
 	if !cfg.writeHeader {
 		records = records[1:]
 	}
@@ -1412,12 +1742,34 @@ func (df DataFrame) WriteCSV(w io.Writer, options ...WriteOption) error {
 	return csv.NewWriter(w).WriteAll(records)
 }
 
-// WriteJSON writes the DataFrame to the given io.Writer as a JSON array.
-func (df DataFrame) WriteJSON(w io.Writer) error {
+// WriteJSON writes the DataFrame to the given io.Writer as JSON, by default as
+// an array of objects with the keys in column order. The Orientation option
+// sets the layout. Missing, NaN and infinite elements are written as null.
+func (df DataFrame) WriteJSON(w io.Writer, options ...WriteOption) error {
 	if df.Err != nil {
 		return df.Err
 	}
-	return json.NewEncoder(w).Encode(df.Maps())
+	cfg := writeOptions{
+		orient: JSONRecords,
+	}
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	bw := bufio.NewWriter(w)
+	var err error
+	switch cfg.orient {
+	case JSONRecords:
+		err = df.writeJSONRecords(bw)
+	case JSONColumns:
+		err = df.writeJSONColumns(bw)
+	default:
+		err = fmt.Errorf("write json: unknown orientation %d", cfg.orient)
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
 }
 
 // Internal state for implementing ReadHTML
@@ -1610,417 +1962,27 @@ func (df DataFrame) Col(colname string) series.Series {
 }
 
 // InnerJoin returns a DataFrame containing the inner join of two DataFrames.
+// See Join.
 func (df DataFrame) InnerJoin(b DataFrame, keys ...string) DataFrame {
-	if len(keys) == 0 {
-		return DataFrame{Err: fmt.Errorf("join keys not specified")}
-	}
-	// Check that we have all given keys in both DataFrames
-	var iKeysA []int
-	var iKeysB []int
-	var errorArr []string
-	for _, key := range keys {
-		i := df.colIndex(key)
-		if i < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on left DataFrame", key))
-		}
-		iKeysA = append(iKeysA, i)
-		j := b.colIndex(key)
-		if j < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on right DataFrame", key))
-		}
-		iKeysB = append(iKeysB, j)
-	}
-	if len(errorArr) != 0 {
-		return DataFrame{Err: fmt.Errorf(strings.Join(errorArr, "\n"))}
-	}
-
-	aCols := df.columns
-	bCols := b.columns
-	// Initialize newCols
-	var newCols []series.Series
-	for _, i := range iKeysA {
-		newCols = append(newCols, aCols[i].Empty())
-	}
-	var iNotKeysA []int
-	for i := 0; i < df.ncols; i++ {
-		if !inIntSlice(i, iKeysA) {
-			iNotKeysA = append(iNotKeysA, i)
-			newCols = append(newCols, aCols[i].Empty())
-		}
-	}
-	var iNotKeysB []int
-	for i := 0; i < b.ncols; i++ {
-		if !inIntSlice(i, iKeysB) {
-			iNotKeysB = append(iNotKeysB, i)
-			newCols = append(newCols, bCols[i].Empty())
-		}
-	}
-
-	// Fill newCols
-	for i := 0; i < df.nrows; i++ {
-		for j := 0; j < b.nrows; j++ {
-			match := true
-			for k := range keys {
-				aElem := aCols[iKeysA[k]].Elem(i)
-				bElem := bCols[iKeysB[k]].Elem(j)
-				match = match && aElem.Eq(bElem)
-			}
-			if match {
-				ii := 0
-				for _, k := range iKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysB {
-					elem := bCols[k].Elem(j)
-					newCols[ii].Append(elem)
-					ii++
-				}
-			}
-		}
-	}
-	return New(newCols...)
+	return df.Join(b, JoinInner, keys)
 }
 
 // LeftJoin returns a DataFrame containing the left join of two DataFrames.
+// See Join.
 func (df DataFrame) LeftJoin(b DataFrame, keys ...string) DataFrame {
-	if len(keys) == 0 {
-		return DataFrame{Err: fmt.Errorf("join keys not specified")}
-	}
-	// Check that we have all given keys in both DataFrames
-	var iKeysA []int
-	var iKeysB []int
-	var errorArr []string
-	for _, key := range keys {
-		i := df.colIndex(key)
-		if i < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on left DataFrame", key))
-		}
-		iKeysA = append(iKeysA, i)
-		j := b.colIndex(key)
-		if j < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on right DataFrame", key))
-		}
-		iKeysB = append(iKeysB, j)
-	}
-	if len(errorArr) != 0 {
-		return DataFrame{Err: fmt.Errorf(strings.Join(errorArr, "\n"))}
-	}
-
-	aCols := df.columns
-	bCols := b.columns
-	// Initialize newCols
-	var newCols []series.Series
-	for _, i := range iKeysA {
-		newCols = append(newCols, aCols[i].Empty())
-	}
-	var iNotKeysA []int
-	for i := 0; i < df.ncols; i++ {
-		if !inIntSlice(i, iKeysA) {
-			iNotKeysA = append(iNotKeysA, i)
-			newCols = append(newCols, aCols[i].Empty())
-		}
-	}
-	var iNotKeysB []int
-	for i := 0; i < b.ncols; i++ {
-		if !inIntSlice(i, iKeysB) {
-			iNotKeysB = append(iNotKeysB, i)
-			newCols = append(newCols, bCols[i].Empty())
-		}
-	}
-
-	// Fill newCols
-	for i := 0; i < df.nrows; i++ {
-		matched := false
-		for j := 0; j < b.nrows; j++ {
-			match := true
-			for k := range keys {
-				aElem := aCols[iKeysA[k]].Elem(i)
-				bElem := bCols[iKeysB[k]].Elem(j)
-				match = match && aElem.Eq(bElem)
-			}
-			if match {
-				matched = true
-				ii := 0
-				for _, k := range iKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysB {
-					elem := bCols[k].Elem(j)
-					newCols[ii].Append(elem)
-					ii++
-				}
-			}
-		}
-		if !matched {
-			ii := 0
-			for _, k := range iKeysA {
-				elem := aCols[k].Elem(i)
-				newCols[ii].Append(elem)
-				ii++
-			}
-			for _, k := range iNotKeysA {
-				elem := aCols[k].Elem(i)
-				newCols[ii].Append(elem)
-				ii++
-			}
-			for range iNotKeysB {
-				newCols[ii].Append(nil)
-				ii++
-			}
-		}
-	}
-	return New(newCols...)
+	return df.Join(b, JoinLeft, keys)
 }
 
 // RightJoin returns a DataFrame containing the right join of two DataFrames.
+// See Join.
 func (df DataFrame) RightJoin(b DataFrame, keys ...string) DataFrame {
-	if len(keys) == 0 {
-		return DataFrame{Err: fmt.Errorf("join keys not specified")}
-	}
-	// Check that we have all given keys in both DataFrames
-	var iKeysA []int
-	var iKeysB []int
-	var errorArr []string
-	for _, key := range keys {
-		i := df.colIndex(key)
-		if i < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on left DataFrame", key))
-		}
-		iKeysA = append(iKeysA, i)
-		j := b.colIndex(key)
-		if j < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on right DataFrame", key))
-		}
-		iKeysB = append(iKeysB, j)
-	}
-	if len(errorArr) != 0 {
-		return DataFrame{Err: fmt.Errorf(strings.Join(errorArr, "\n"))}
-	}
-
-	aCols := df.columns
-	bCols := b.columns
-	// Initialize newCols
-	var newCols []series.Series
-	for _, i := range iKeysA {
-		newCols = append(newCols, aCols[i].Empty())
-	}
-	var iNotKeysA []int
-	for i := 0; i < df.ncols; i++ {
-		if !inIntSlice(i, iKeysA) {
-			iNotKeysA = append(iNotKeysA, i)
-			newCols = append(newCols, aCols[i].Empty())
-		}
-	}
-	var iNotKeysB []int
-	for i := 0; i < b.ncols; i++ {
-		if !inIntSlice(i, iKeysB) {
-			iNotKeysB = append(iNotKeysB, i)
-			newCols = append(newCols, bCols[i].Empty())
-		}
-	}
-
-	// Fill newCols
-	var yesmatched []struct{ i, j int }
-	var nonmatched []int
-	for j := 0; j < b.nrows; j++ {
-		matched := false
-		for i := 0; i < df.nrows; i++ {
-			match := true
-			for k := range keys {
-				aElem := aCols[iKeysA[k]].Elem(i)
-				bElem := bCols[iKeysB[k]].Elem(j)
-				match = match && aElem.Eq(bElem)
-			}
-			if match {
-				matched = true
-				yesmatched = append(yesmatched, struct{ i, j int }{i, j})
-			}
-		}
-		if !matched {
-			nonmatched = append(nonmatched, j)
-		}
-	}
-	for _, v := range yesmatched {
-		i := v.i
-		j := v.j
-		ii := 0
-		for _, k := range iKeysA {
-			elem := aCols[k].Elem(i)
-			newCols[ii].Append(elem)
-			ii++
-		}
-		for _, k := range iNotKeysA {
-			elem := aCols[k].Elem(i)
-			newCols[ii].Append(elem)
-			ii++
-		}
-		for _, k := range iNotKeysB {
-			elem := bCols[k].Elem(j)
-			newCols[ii].Append(elem)
-			ii++
-		}
-	}
-	for _, j := range nonmatched {
-		ii := 0
-		for _, k := range iKeysB {
-			elem := bCols[k].Elem(j)
-			newCols[ii].Append(elem)
-			ii++
-		}
-		for range iNotKeysA {
-			newCols[ii].Append(nil)
-			ii++
-		}
-		for _, k := range iNotKeysB {
-			elem := bCols[k].Elem(j)
-			newCols[ii].Append(elem)
-			ii++
-		}
-	}
-	return New(newCols...)
+	return df.Join(b, JoinRight, keys)
 }
 
 // OuterJoin returns a DataFrame containing the outer join of two DataFrames.
+// See Join.
 func (df DataFrame) OuterJoin(b DataFrame, keys ...string) DataFrame {
-	if len(keys) == 0 {
-		return DataFrame{Err: fmt.Errorf("join keys not specified")}
-	}
-	// Check that we have all given keys in both DataFrames
-	var iKeysA []int
-	var iKeysB []int
-	var errorArr []string
-	for _, key := range keys {
-		i := df.colIndex(key)
-		if i < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on left DataFrame", key))
-		}
-		iKeysA = append(iKeysA, i)
-		j := b.colIndex(key)
-		if j < 0 {
-			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on right DataFrame", key))
-		}
-		iKeysB = append(iKeysB, j)
-	}
-	if len(errorArr) != 0 {
-		return DataFrame{Err: fmt.Errorf(strings.Join(errorArr, "\n"))}
-	}
-
-	aCols := df.columns
-	bCols := b.columns
-	// Initialize newCols
-	var newCols []series.Series
-	for _, i := range iKeysA {
-		newCols = append(newCols, aCols[i].Empty())
-	}
-	var iNotKeysA []int
-	for i := 0; i < df.ncols; i++ {
-		if !inIntSlice(i, iKeysA) {
-			iNotKeysA = append(iNotKeysA, i)
-			newCols = append(newCols, aCols[i].Empty())
-		}
-	}
-	var iNotKeysB []int
-	for i := 0; i < b.ncols; i++ {
-		if !inIntSlice(i, iKeysB) {
-			iNotKeysB = append(iNotKeysB, i)
-			newCols = append(newCols, bCols[i].Empty())
-		}
-	}
-
-	// Fill newCols
-	for i := 0; i < df.nrows; i++ {
-		matched := false
-		for j := 0; j < b.nrows; j++ {
-			match := true
-			for k := range keys {
-				aElem := aCols[iKeysA[k]].Elem(i)
-				bElem := bCols[iKeysB[k]].Elem(j)
-				match = match && aElem.Eq(bElem)
-			}
-			if match {
-				matched = true
-				ii := 0
-				for _, k := range iKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysA {
-					elem := aCols[k].Elem(i)
-					newCols[ii].Append(elem)
-					ii++
-				}
-				for _, k := range iNotKeysB {
-					elem := bCols[k].Elem(j)
-					newCols[ii].Append(elem)
-					ii++
-				}
-			}
-		}
-		if !matched {
-			ii := 0
-			for _, k := range iKeysA {
-				elem := aCols[k].Elem(i)
-				newCols[ii].Append(elem)
-				ii++
-			}
-			for _, k := range iNotKeysA {
-				elem := aCols[k].Elem(i)
-				newCols[ii].Append(elem)
-				ii++
-			}
-			for range iNotKeysB {
-				newCols[ii].Append(nil)
-				ii++
-			}
-		}
-	}
-	for j := 0; j < b.nrows; j++ {
-		matched := false
-		for i := 0; i < df.nrows; i++ {
-			match := true
-			for k := range keys {
-				aElem := aCols[iKeysA[k]].Elem(i)
-				bElem := bCols[iKeysB[k]].Elem(j)
-				match = match && aElem.Eq(bElem)
-			}
-			if match {
-				matched = true
-			}
-		}
-		if !matched {
-			ii := 0
-			for _, k := range iKeysB {
-				elem := bCols[k].Elem(j)
-				newCols[ii].Append(elem)
-				ii++
-			}
-			for range iNotKeysA {
-				newCols[ii].Append(nil)
-				ii++
-			}
-			for _, k := range iNotKeysB {
-				elem := bCols[k].Elem(j)
-				newCols[ii].Append(elem)
-				ii++
-			}
-		}
-	}
-	return New(newCols...)
+	return df.Join(b, JoinOuter, keys)
 }
 
 // CrossJoin returns a DataFrame containing the cross join of two DataFrames.
@@ -2232,12 +2194,25 @@ func parseSelectIndexes(l int, indexes SelectIndexes, colnames []string) ([]int,
 	return idx, nil
 }
 
-func findType(arr []string) (series.Type, error) {
-	var hasFloats, hasInts, hasBools, hasStrings bool
+func findType(arr []string, detectTimes bool, timeLayout string) (series.Type, error) {
+	var hasFloats, hasInts, hasBools, hasStrings, hasTimes bool
+	isTime := func(str string) bool {
+		if timeLayout != "" {
+			_, err := time.Parse(timeLayout, str)
+			return err == nil
+		}
+		_, err := series.ParseTime(str)
+		return err == nil
+	}
 	for _, str := range arr {
 		if str == "" || str == "NaN" {
 			continue
 		}
+		// An explicit layout takes precedence over numbers, as in "20060102"
+		if detectTimes && timeLayout != "" && isTime(str) {
+			hasTimes = true
+			continue
+		}
 		if _, err := strconv.Atoi(str); err == nil {
 			hasInts = true
 			continue
@@ -2250,12 +2225,20 @@ func findType(arr []string) (series.Type, error) {
 			hasBools = true
 			continue
 		}
+		if detectTimes && timeLayout == "" && isTime(str) {
+			hasTimes = true
+			continue
+		}
 		hasStrings = true
 	}
 
 	switch {
 	case hasStrings:
 		return series.String, nil
+	case hasTimes && (hasBools || hasFloats || hasInts):
+		return series.String, nil
+	case hasTimes:
+		return series.Time, nil
 	case hasBools:
 		return series.Bool, nil
 	case hasFloats:
@@ -2318,7 +2301,7 @@ func (df DataFrame) Describe() DataFrame {
 	for _, col := range df.columns {
 		var newCol series.Series
 		switch col.Type() {
-		case series.String:
+		case series.String, series.Categorical:
 			newCol = series.New([]string{
 				"-",
 				"-",
@@ -2329,14 +2312,35 @@ func (df DataFrame) Describe() DataFrame {
 				"-",
 				col.MaxStr(),
 			},
-				col.Type(),
+				series.String,
 				col.Name,
 			)
-		case series.Bool:
-			fallthrough
-		case series.Float:
-			fallthrough
-		case series.Int:
+		case series.Time:
+			min, max := "-", "-"
+			if ordered := col.Subset(col.Order(false)); ordered.Len() > 0 && !ordered.Elem(0).IsNA() {
+				min = ordered.Elem(0).String()
+				for i := ordered.Len() - 1; i >= 0; i-- {
+					if !ordered.Elem(i).IsNA() {
+						max = ordered.Elem(i).String()
+						break
+					}
+				}
+			}
+			newCol = series.New([]string{
+				"-",
+				"-",
+				"-",
+				min,
+				"-",
+				"-",
+				"-",
+				max,
+			},
+				series.String,
+				col.Name,
+			)
+		case series.Bool, series.Float, series.Int,
+			series.Int64, series.Int32, series.Uint64, series.Float32:
 			newCol = series.New([]float64{
 				col.Mean(),
 				col.Median(),
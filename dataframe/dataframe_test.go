@@ -1214,6 +1214,51 @@ func TestLoadRecords(t *testing.T) {
 			),
 			false,
 		},
+		{
+			LoadRecords(
+				[][]string{
+					{"A", "B", "C"},
+					{"2021-10-10", "2021-10-10T12:30:00Z", "2021-10-10"},
+					{"NaN", "2021-10-11 08:00:00", "1"},
+				},
+				DetectTimes(true),
+			),
+			New(
+				series.New([]string{"2021-10-10", "NaN"}, series.Time, "A"),
+				series.New([]string{"2021-10-10T12:30:00Z", "2021-10-11T08:00:00Z"}, series.Time, "B"),
+				series.New([]string{"2021-10-10", "1"}, series.String, "C"),
+			),
+			false,
+		},
+		{
+			LoadRecords(
+				[][]string{
+					{"A", "B"},
+					{"2021-10-10", "2021-10-10T12:30:00Z"},
+				},
+			),
+			New(
+				series.New([]string{"2021-10-10"}, series.String, "A"),
+				series.New([]string{"2021-10-10T12:30:00Z"}, series.String, "B"),
+			),
+			false,
+		},
+		{
+			LoadRecords(
+				[][]string{
+					{"A", "B"},
+					{"10/10/2021 12:30", "20211010"},
+					{"11/10/2021 08:00", "20211011"},
+				},
+				WithTimeLayout("02/01/2006 15:04"),
+				WithTypes(map[string]series.Type{"B": series.Int}),
+			),
+			New(
+				series.New([]string{"2021-10-10T12:30:00Z", "2021-10-11T08:00:00Z"}, series.Time, "A"),
+				series.New([]int{20211010, 20211011}, series.Int, "B"),
+			),
+			false,
+		},
 	}
 
 	for i, tc := range table {
@@ -1400,6 +1445,67 @@ func TestLoadMaps(t *testing.T) {
 	}
 }
 
+func TestLoadColumns(t *testing.T) {
+	names := []string{"A", "B", "C"}
+	source := []series.Series{
+		series.New([]string{"a", "b"}, series.String, "A"),
+		series.New([]int{1, 2}, series.Int, "B"),
+		series.New([]float64{1.5, 2.5}, series.Float, "C"),
+	}
+	load := func(indices []int) ([]series.Series, error) {
+		columns := make([]series.Series, len(indices))
+		for i, j := range indices {
+			columns[i] = source[j]
+		}
+		return columns, nil
+	}
+	table := []struct {
+		options []LoadOption
+		expDf   DataFrame
+	}{
+		{
+			nil,
+			New(source...),
+		},
+		{
+			[]LoadOption{SelectColumns("C", "A")},
+			New(source[2], source[0]),
+		},
+		{
+			[]LoadOption{
+				SelectColumns("B"),
+				Names("X"),
+				WithTypes(map[string]series.Type{"X": series.Float}),
+			},
+			New(series.New([]float64{1, 2}, series.Float, "X")),
+		},
+	}
+	for i, tc := range table {
+		b := LoadColumns(names, load, tc.options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	for i, options := range [][]LoadOption{
+		{SelectColumns("Z")},
+		{Names("X")},
+		{WithSchema(Schema{{Name: "B", Type: series.Int}, {Name: "Z", Type: series.Int}})},
+	} {
+		if b := LoadColumns(names, load, options...); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+	failing := func(indices []int) ([]series.Series, error) {
+		return nil, fmt.Errorf("can't read")
+	}
+	if b := LoadColumns(names, failing); b.Err == nil {
+		t.Errorf("Expected error from load")
+	}
+}
+
 func TestReadCSV(t *testing.T) {
 	// Load the data from a CSV string and try to infer the type of the
 	// columns
@@ -1442,10 +1548,10 @@ func TestReadJSON(t *testing.T) {
 			`[{"COL.2":1,"COL.3":3},{"COL.1":5,"COL.2":2,"COL.3":2},{"COL.1":6,"COL.2":3,"COL.3":1}]`,
 			LoadRecords(
 				[][]string{
-					{"COL.1", "COL.2", "COL.3"},
-					{"NaN", "1", "3"},
-					{"5", "2", "2"},
-					{"6", "3", "1"},
+					{"COL.2", "COL.3", "COL.1"},
+					{"1", "3", "NaN"},
+					{"2", "2", "5"},
+					{"3", "1", "6"},
 				},
 				DetectTypes(false),
 				DefaultType(series.Int),
@@ -1987,7 +2093,7 @@ func TestDataFrame_WriteCSV(t *testing.T) {
 			),
 			nil,
 			`COL.1,COL.2,COL.3
-NaN,1,3
+,1,3
 b,2,2
 c,3,1
 `,
@@ -2003,7 +2109,7 @@ c,3,1
 			),
 			nil,
 			`COL.1,COL.2,COL.3
-NaN,1,3
+,1,3
 b,2,2
 c,3,1
 `,
@@ -2018,7 +2124,7 @@ c,3,1
 				},
 			),
 			[]WriteOption{WriteHeader(false)},
-			`NaN,1,3
+			`,1,3
 b,2,2
 c,3,1
 `,
@@ -2037,6 +2143,78 @@ c,3,1
 	}
 }
 
+func TestDataFrame_WriteCSV_Nulls(t *testing.T) {
+	a := New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+	)
+	table := []struct {
+		writeOptions []WriteOption
+		loadOptions  []LoadOption
+		expected     string
+		// Columns whose missing elements are loaded back as missing
+		nullColumns []string
+	}{
+		{
+			nil,
+			nil,
+			`A,B,C,D
+a,1,,true
+,2,1.500000,
+c,,nan,false
+`,
+			[]string{"B", "C", "D"},
+		},
+		{
+			nil,
+			[]LoadOption{NaNValues([]string{"", "NA", "NaN", "<nil>"})},
+			`A,B,C,D
+a,1,,true
+,2,1.500000,
+c,,nan,false
+`,
+			[]string{"A", "B", "C", "D"},
+		},
+		{
+			[]WriteOption{NullValue("NA")},
+			nil,
+			`A,B,C,D
+a,1,NA,true
+NA,2,1.500000,NA
+c,NA,nan,false
+`,
+			[]string{"A", "B", "C", "D"},
+		},
+	}
+	for i, tc := range table {
+		buf := new(bytes.Buffer)
+		if err := a.WriteCSV(buf, tc.writeOptions...); err != nil {
+			t.Fatalf("Test: %d\nExpected success, got error: %v", i, err)
+		}
+		if tc.expected != buf.String() {
+			t.Errorf("Test: %d\nexpected: %v\nreceived: %v", i, tc.expected, buf.String())
+		}
+
+		b := ReadCSV(buf, tc.loadOptions...)
+		if b.Err != nil {
+			t.Fatalf("Test: %d\nExpected success, got error: %v", i, b.Err)
+		}
+		if !reflect.DeepEqual(a.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, a.Types(), b.Types())
+		}
+		for _, name := range tc.nullColumns {
+			if !reflect.DeepEqual(a.Col(name).IsNull(), b.Col(name).IsNull()) {
+				t.Errorf("Test: %d\nDifferent nulls on %s:\nA:%v\nB:%v", i, name, a.Col(name).IsNull(), b.Col(name).IsNull())
+			}
+		}
+		if !reflect.DeepEqual(a.Col("C").IsNaN(), b.Col("C").IsNaN()) {
+			t.Errorf("Test: %d\nDifferent NaNs:\nA:%v\nB:%v", i, a.Col("C").IsNaN(), b.Col("C").IsNaN())
+		}
+	}
+}
+
 func TestDataFrame_WriteJSON(t *testing.T) {
 	a := LoadRecords(
 		[][]string{
@@ -2510,7 +2688,7 @@ func TestDataFrame_Capply(t *testing.T) {
 			LoadRecords(
 				[][]string{
 					{"A", "B", "C", "D"},
-					{"NaN", "3.25", "6.05", "0.5"},
+					{"nan", "3.25", "6.05", "0.5"},
 				},
 				DefaultType(series.Float),
 				DetectTypes(false),
@@ -2521,7 +2699,7 @@ func TestDataFrame_Capply(t *testing.T) {
 			LoadRecords(
 				[][]string{
 					{"A", "B", "C", "D"},
-					{"NaN", "13", "24.2", "2"},
+					{"nan", "13", "24.2", "2"},
 				},
 				DefaultType(series.Float),
 				DetectTypes(false),
@@ -3000,3 +3178,181 @@ func TestGroups_GetGroups(t *testing.T) {
 		t.Fatalf("Expected to get 3 groups, got %d", len(groupNames))
 	}
 }
+
+func TestDataFrame_GroupBy_Order(t *testing.T) {
+	a := New(
+		series.New([]string{"b_1", "a", "b", "a", "b", "b_1"}, series.String, "key1"),
+		series.New([]interface{}{2, 2, "1_2", 2, nil, 2}, series.String, "key2"),
+		series.New([]float64{2.5, 1.5, 1.5, 2.5, 0.5, 1}, series.Float, "key3"),
+		series.New([]float64{1, 2, 3, 4, 5, 6}, series.Float, "values"),
+	)
+	table := []struct {
+		groups *Groups
+		expDf  DataFrame
+	}{
+		{
+			a.GroupBy("key1", "key2"),
+			New(
+				series.New([]string{"b_1", "a", "b", "b"}, series.String, "key1"),
+				series.New([]interface{}{"2", "2", "1_2", nil}, series.String, "key2"),
+				series.New([]float64{7, 6, 3, 5}, series.Float, "values_SUM"),
+			),
+		},
+		{
+			a.GroupBy("key1", "key2").SortByKey(),
+			New(
+				series.New([]string{"a", "b", "b", "b_1"}, series.String, "key1"),
+				series.New([]interface{}{"2", "1_2", nil, "2"}, series.String, "key2"),
+				series.New([]float64{6, 3, 5, 7}, series.Float, "values_SUM"),
+			),
+		},
+		{
+			a.GroupBy("key3").SortByKey(),
+			New(
+				series.New([]float64{0.5, 1, 1.5, 2.5}, series.Float, "key3"),
+				series.New([]float64{5, 6, 5, 5}, series.Float, "values_SUM"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := tc.groups.Aggregation([]AggregationType{Aggregation_SUM}, []string{"values"})
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), b.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), b.Records())
+		}
+	}
+
+	// "b_1" + "2" and "b" + "1_2" share the same id, but are different groups.
+	groups := a.GroupBy("key1", "key2").GetGroups()
+	if len(groups) != 4 {
+		t.Fatalf("Expected to get 4 groups, got %d", len(groups))
+	}
+	if g, ok := groups["b_1_2"]; !ok || g.Nrow() != 2 {
+		t.Errorf("Expected group b_1_2 with 2 rows")
+	}
+	if g, ok := groups["b_1_2_1"]; !ok || g.Nrow() != 1 {
+		t.Errorf("Expected group b_1_2_1 with 1 row")
+	}
+	if g, ok := groups["b_NaN"]; !ok || g.Nrow() != 1 {
+		t.Errorf("Expected group b_NaN with 1 row")
+	}
+	if !reflect.DeepEqual(groups["a_2"].Types(), a.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", a.Types(), groups["a_2"].Types())
+	}
+}
+
+func TestLoadStructs_Sized(t *testing.T) {
+	type testStruct struct {
+		A int64
+		B int32
+		C uint64
+		D float32
+		E int16
+		F int64 `dataframe:"f,int"`
+	}
+	data := []testStruct{
+		{1 << 62, -5, 1 << 63, 0.5, 7, 10},
+		{2, 3, 4, 1.25, -8, 20},
+	}
+	df := LoadStructs(data)
+	if df.Err != nil {
+		t.Fatalf("Error: %v", df.Err)
+	}
+	expTypes := []series.Type{series.Int64, series.Int32, series.Uint64, series.Float32, series.Int, series.Int}
+	if !reflect.DeepEqual(expTypes, df.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expTypes, df.Types())
+	}
+	expected := [][]string{
+		{"A", "B", "C", "D", "E", "f"},
+		{"4611686018427387904", "-5", "9223372036854775808", "0.500000", "7", "10"},
+		{"2", "3", "4", "1.250000", "-8", "20"},
+	}
+	if !reflect.DeepEqual(expected, df.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, df.Records())
+	}
+}
+
+func TestReadCSV_Sized(t *testing.T) {
+	csvStr := `id,count,ratio
+9007199254740993,1,0.5
+18446744073709551615,-2,NaN`
+	df := ReadCSV(
+		strings.NewReader(csvStr),
+		WithTypes(map[string]series.Type{
+			"id":    series.Uint64,
+			"count": series.Int32,
+			"ratio": series.Float32,
+		}),
+	)
+	if df.Err != nil {
+		t.Fatalf("Error: %v", df.Err)
+	}
+	expTypes := []series.Type{series.Uint64, series.Int32, series.Float32}
+	if !reflect.DeepEqual(expTypes, df.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expTypes, df.Types())
+	}
+	expected := [][]string{
+		{"id", "count", "ratio"},
+		{"9007199254740993", "1", "0.500000"},
+		{"18446744073709551615", "-2", "NaN"},
+	}
+	if !reflect.DeepEqual(expected, df.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, df.Records())
+	}
+	if !df.Col("ratio").Elem(1).IsNull() {
+		t.Errorf("Expected missing ratio")
+	}
+}
+
+func TestDataFrame_Mutate_Arithmetic(t *testing.T) {
+	a := New(
+		series.New([]int{1, 2, 3}, series.Int, "qty"),
+		series.New([]interface{}{1.5, nil, 2.0}, series.Float, "price"),
+	)
+	b := a.Mutate(a.Col("qty").Mul(a.Col("price")).Rename("total")).
+		Mutate(a.Col("qty").Add(1))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := [][]string{
+		{"qty", "price", "total"},
+		{"2", "1.500000", "1.500000"},
+		{"3", "NaN", "NaN"},
+		{"4", "2.000000", "6.000000"},
+	}
+	if !reflect.DeepEqual(expected, b.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, b.Records())
+	}
+	expTypes := []series.Type{series.Int, series.Float, series.Float}
+	if !reflect.DeepEqual(expTypes, b.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expTypes, b.Types())
+	}
+
+	if c := a.Mutate(a.Col("qty").Add("x")); c.Err == nil {
+		t.Errorf("Expected error on invalid arithmetic")
+	}
+}
+
+func compareFrames(t *testing.T, i int, a, b DataFrame) {
+	t.Helper()
+	if !reflect.DeepEqual(a.Types(), b.Types()) {
+		t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, a.Types(), b.Types())
+	}
+	if !reflect.DeepEqual(a.Names(), b.Names()) {
+		t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, a.Names(), b.Names())
+	}
+	if !reflect.DeepEqual(a.Records(), b.Records()) {
+		t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, a.Records(), b.Records())
+	}
+	for _, name := range a.Names() {
+		if !reflect.DeepEqual(a.Col(name).IsNull(), b.Col(name).IsNull()) {
+			t.Errorf("Test: %d\nDifferent nulls on %s:\nA:%v\nB:%v", i, name, a.Col(name).IsNull(), b.Col(name).IsNull())
+		}
+	}
+}
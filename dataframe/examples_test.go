@@ -173,10 +173,10 @@ func ExampleReadJSON() {
 	// Output:
 	// [3x3] DataFrame
 	//
-	//     COL.1 COL.2 COL.3
-	//  0: NaN   1     3
-	//  1: 5     2     2
-	//  2: 6     3     1
+	//     COL.2 COL.3 COL.1
+	//  0: 1     3     NaN
+	//  1: 2     2     5
+	//  2: 3     1     6
 	//     <int> <int> <int>
 
 }
@@ -320,7 +320,7 @@ func ExampleDataFrame_Mutate() {
 	//  2: k        4     c        true
 	//  3: a        2     d        false
 	//     <string> <int> <string> <bool>
-
+	//
 	// [4x5] DataFrame
 	//
 	//     A        B     C        D      E
@@ -447,7 +447,7 @@ func ExampleDataFrame_Describe() {
 	//
 	//     column   A        B        C        D
 	//  0: mean     -        3.250000 6.050000 0.500000
-	//  1: median   -        3.500000 6.000000 NaN
+	//  1: median   -        3.500000 6.000000 nan
 	//  2: stddev   -        0.957427 0.818535 0.577350
 	//  3: min      a        2.000000 5.100000 0.000000
 	//  4: 25%      -        2.000000 5.100000 0.000000
@@ -0,0 +1,781 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-gota/gota/series"
+)
+
+// FilterExpr returns the rows of the DataFrame for which the given boolean
+// expression is true. Rows where it evaluates to a missing value are dropped.
+// See Eval for the syntax of the expressions. It is the same as
+// df.Filter(Expr(expr)).
+func (df DataFrame) FilterExpr(expr string) DataFrame {
+	return df.Filter(Expr(expr))
+}
+
+// exprComparator marks the filters built by Expr.
+const exprComparator series.Comparator = "expr"
+
+// Expr returns a filter that keeps the rows for which the given boolean
+// expression is true, so that expressions can be combined with other filters
+// in Filter and FilterAggregation. See Eval for the syntax of the expressions.
+func Expr(expr string) F {
+	return F{Comparator: exprComparator, Comparando: expr}
+}
+
+// filterExpr evaluates the boolean expression of an Expr filter, as a Bool
+// Series which is false where the expression is missing.
+func filterExpr(df DataFrame, expr string) (series.Series, error) {
+	res, err := evalExpr(df, expr)
+	if err != nil {
+		return series.Series{}, err
+	}
+	if res.typ != series.Bool {
+		return series.Series{}, fmt.Errorf("expression must be bool, got %s", res.typ)
+	}
+	rows := make([]bool, df.nrows)
+	for i := range rows {
+		rows[i] = res.bools[i] && !res.isNull(i)
+	}
+	return series.Bools(rows), nil
+}
+
+// MutateExpr evaluates the given expression and stores the result in the
+// column colname, replacing it if it already exists. See Eval for the syntax
+// of the expressions.
+func (df DataFrame) MutateExpr(colname, expr string) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	s := df.Eval(expr)
+	if s.Err != nil {
+		return DataFrame{Err: fmt.Errorf("mutate: %v", s.Err)}
+	}
+	s.Name = colname
+	return df.Mutate(s)
+}
+
+// Eval evaluates an expression over every row of the DataFrame and returns the
+// resulting Series. Expressions are made of:
+//
+//	column names    // age, `first name` (backquoted if not an identifier)
+//	literals        // 42, 0.8, 'NYC', "NYC", true, false
+//	arithmetic      // -x, x + y, x - y, x * y, x / y, x % y
+//	comparisons     // x == y, x != y, x > y, x >= y, x < y, x <= y
+//	logic           // !x, x && y, x || y
+//	parentheses     // (x || y) && z
+//
+// Expressions are type checked against the types of the columns before being
+// evaluated. Int and Float operands can be mixed, and the result is Float
+// unless both are Int; the division always returns Float. The + operator also
+// concatenates String values. Values of the same type can be compared, and
+// Time columns can be compared with string literals, which are parsed with
+// series.ParseTime.
+//
+// Missing values propagate through arithmetic and comparisons. The logical
+// operators follow three-valued logic, so that false && x is false and
+// true || x is true even if x is missing.
+func (df DataFrame) Eval(expr string) series.Series {
+	if df.Err != nil {
+		return series.Series{Err: df.Err}
+	}
+	res, err := evalExpr(df, expr)
+	if err != nil {
+		return series.Series{Err: err}
+	}
+	return res.series(expr)
+}
+
+func evalExpr(df DataFrame, expr string) (*exprVector, error) {
+	root, err := parseExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("expr: %v", err)
+	}
+	if err := root.check(df); err != nil {
+		return nil, fmt.Errorf("expr: %v", err)
+	}
+	return root.eval(df), nil
+}
+
+// Lexer
+
+type exprTokenKind int
+
+const (
+	exprEOF exprTokenKind = iota
+	exprNumber
+	exprString
+	exprIdent
+	exprQuotedIdent
+	exprOperator
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// isIdentChar reports whether b can be part of an unquoted column name.
+func isIdentChar(b byte) bool {
+	return b == '_' || b == '.' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// exprOperators are sorted so that the longest operators are matched first.
+var exprOperators = []string{
+	"||", "&&", "==", "!=", ">=", "<=",
+	">", "<", "!", "+", "-", "*", "/", "%", "(", ")",
+}
+
+func lexExpr(s string) ([]exprToken, error) {
+	var tokens []exprToken
+	i := 0
+	for i < len(s) {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9':
+			start := i
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+				i++
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				i++
+				if i < len(s) && (s[i] == '+' || s[i] == '-') {
+					i++
+				}
+				for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+					i++
+				}
+			}
+			tokens = append(tokens, exprToken{exprNumber, s[start:i], start})
+		case c == '\'' || c == '"' || c == '`':
+			start := i
+			var sb strings.Builder
+			i++
+			for i < len(s) && rune(s[i]) != c {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated %c at position %d", c, start)
+			}
+			i++
+			kind := exprString
+			if c == '`' {
+				kind = exprQuotedIdent
+			}
+			tokens = append(tokens, exprToken{kind, sb.String(), start})
+		case isIdentChar(s[i]) && !(c >= '0' && c <= '9') && c != '.':
+			start := i
+			for i < len(s) && isIdentChar(s[i]) {
+				i++
+			}
+			tokens = append(tokens, exprToken{exprIdent, s[start:i], start})
+		default:
+			found := false
+			for _, op := range exprOperators {
+				if strings.HasPrefix(s[i:], op) {
+					tokens = append(tokens, exprToken{exprOperator, op, i})
+					i += len(op)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+		}
+	}
+	return append(tokens, exprToken{exprEOF, "", len(s)}), nil
+}
+
+// Parser
+
+type exprNodeKind int
+
+const (
+	exprLiteral exprNodeKind = iota
+	exprColumn
+	exprUnary
+	exprBinary
+)
+
+// exprNode is a node of the syntax tree of an expression. Its type is set when
+// the tree is checked against a DataFrame.
+type exprNode struct {
+	kind  exprNodeKind
+	op    string
+	name  string
+	value interface{}
+	args  []*exprNode
+	pos   int
+	typ   series.Type
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+// exprPrecedence lists the binary operators from the lowest to the highest
+// precedence.
+var exprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", ">", ">=", "<", "<="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func parseExpr(s string) (*exprNode, error) {
+	tokens, err := lexExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	node, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+	return node, nil
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) binary(level int) (*exprNode, error) {
+	if level == len(exprPrecedence) {
+		return p.unary()
+	}
+	x, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != exprOperator || findInStringSlice(tok.text, exprPrecedence[level]) == -1 {
+			return x, nil
+		}
+		p.next()
+		y, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		// Comparisons can't be chained.
+		if level == 2 && p.peek().kind == exprOperator && findInStringSlice(p.peek().text, exprPrecedence[level]) != -1 {
+			return nil, fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+		}
+		x = &exprNode{kind: exprBinary, op: tok.text, args: []*exprNode{x, y}, pos: tok.pos}
+	}
+}
+
+func (p *exprParser) unary() (*exprNode, error) {
+	tok := p.peek()
+	if tok.kind == exprOperator && (tok.text == "-" || tok.text == "!") {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprNode{kind: exprUnary, op: tok.text, args: []*exprNode{x}, pos: tok.pos}, nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (*exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprNumber:
+		if i, err := strconv.Atoi(tok.text); err == nil {
+			return &exprNode{kind: exprLiteral, value: i, typ: series.Int, pos: tok.pos}, nil
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return &exprNode{kind: exprLiteral, value: f, typ: series.Float, pos: tok.pos}, nil
+	case exprString:
+		return &exprNode{kind: exprLiteral, value: tok.text, typ: series.String, pos: tok.pos}, nil
+	case exprIdent:
+		if tok.text == "true" || tok.text == "false" {
+			return &exprNode{kind: exprLiteral, value: tok.text == "true", typ: series.Bool, pos: tok.pos}, nil
+		}
+		return &exprNode{kind: exprColumn, name: tok.text, pos: tok.pos}, nil
+	case exprQuotedIdent:
+		return &exprNode{kind: exprColumn, name: tok.text, pos: tok.pos}, nil
+	case exprOperator:
+		if tok.text == "(" {
+			x, err := p.binary(0)
+			if err != nil {
+				return nil, err
+			}
+			if closing := p.next(); closing.text != ")" || closing.kind != exprOperator {
+				return nil, fmt.Errorf("missing ')' at position %d", closing.pos)
+			}
+			return x, nil
+		}
+	case exprEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+// Type checking
+
+func isNumeric(t series.Type) bool {
+	switch t {
+	case series.Int, series.Float, series.Int64, series.Int32, series.Uint64, series.Float32:
+		return true
+	}
+	return false
+}
+
+// isSigned returns whether t is a signed integer type, evaluated on the ints of
+// an exprVector.
+func isSigned(t series.Type) bool {
+	return t == series.Int || t == series.Int64 || t == series.Int32
+}
+
+// literalType returns the type used for a numeric literal of type t in an
+// operation with a value of type other, so that literals don't widen the types
+// of the columns.
+func literalType(t, other series.Type) series.Type {
+	switch {
+	case t == series.Int && (isSigned(other) || other == series.Uint64):
+		return other
+	case t == series.Float && other == series.Float32:
+		return other
+	}
+	return t
+}
+
+func (n *exprNode) check(df DataFrame) error {
+	for _, arg := range n.args {
+		if err := arg.check(df); err != nil {
+			return err
+		}
+	}
+	switch n.kind {
+	case exprColumn:
+		idx := findInStringSlice(n.name, df.Names())
+		if idx < 0 {
+			return fmt.Errorf("can't find column name %q at position %d", n.name, n.pos)
+		}
+		n.typ = df.columns[idx].Type()
+		if n.typ == series.Categorical {
+			// Categories are compared as strings
+			n.typ = series.String
+		}
+	case exprUnary:
+		x := n.args[0].typ
+		switch {
+		case n.op == "-" && isNumeric(x) && x != series.Uint64:
+			n.typ = x
+		case n.op == "!" && x == series.Bool:
+			n.typ = series.Bool
+		default:
+			return fmt.Errorf("operator %s not defined on %s at position %d", n.op, x, n.pos)
+		}
+	case exprBinary:
+		return n.checkBinary()
+	}
+	return nil
+}
+
+func (n *exprNode) checkBinary() error {
+	x, y := n.args[0], n.args[1]
+	mismatch := fmt.Errorf("operator %s not defined on %s and %s at position %d", n.op, x.typ, y.typ, n.pos)
+	switch n.op {
+	case "&&", "||":
+		if x.typ != series.Bool || y.typ != series.Bool {
+			return mismatch
+		}
+		n.typ = series.Bool
+	case "+", "-", "*", "/", "%":
+		switch {
+		case n.op == "+" && x.typ == series.String && y.typ == series.String:
+			n.typ = series.String
+		case !isNumeric(x.typ) || !isNumeric(y.typ):
+			return mismatch
+		default:
+			xt, yt := x.typ, y.typ
+			if x.kind == exprLiteral {
+				xt = literalType(xt, yt)
+			}
+			if y.kind == exprLiteral {
+				yt = literalType(yt, xt)
+			}
+			n.typ, _ = series.PromoteTypes(xt, yt)
+			if n.op == "/" && n.typ != series.Float32 {
+				n.typ = series.Float
+			}
+		}
+	default:
+		// Time columns can be compared with string literals.
+		for _, pair := range [][2]*exprNode{{x, y}, {y, x}} {
+			if pair[0].typ == series.Time && pair[1].kind == exprLiteral && pair[1].typ == series.String {
+				t, err := series.ParseTime(pair[1].value.(string))
+				if err != nil {
+					return fmt.Errorf("invalid time %q at position %d", pair[1].value, pair[1].pos)
+				}
+				pair[1].value = t
+				pair[1].typ = series.Time
+			}
+		}
+		switch {
+		case isNumeric(x.typ) && isNumeric(y.typ):
+		case x.typ != y.typ:
+			return mismatch
+		case x.typ == series.Bool && n.op != "==" && n.op != "!=":
+			return mismatch
+		}
+		n.typ = series.Bool
+	}
+	return nil
+}
+
+// Evaluation
+
+// exprVector holds the values of an evaluated expression. Only the slice
+// matching its type is set: ints for signed integers, uints for Uint64 and
+// floats for Float and Float32.
+type exprVector struct {
+	typ    series.Type
+	ints   []int64
+	uints  []uint64
+	floats []float64
+	strs   []string
+	bools  []bool
+	times  []time.Time
+	null   []bool
+}
+
+func newExprVector(t series.Type, n int) *exprVector {
+	v := &exprVector{typ: t, null: make([]bool, n)}
+	switch t {
+	case series.Int, series.Int64, series.Int32:
+		v.ints = make([]int64, n)
+	case series.Uint64:
+		v.uints = make([]uint64, n)
+	case series.Float, series.Float32:
+		v.floats = make([]float64, n)
+	case series.String:
+		v.strs = make([]string, n)
+	case series.Bool:
+		v.bools = make([]bool, n)
+	case series.Time:
+		v.times = make([]time.Time, n)
+	}
+	return v
+}
+
+func (v *exprVector) isNull(i int) bool {
+	return v.null[i]
+}
+
+func (v *exprVector) float(i int) float64 {
+	switch {
+	case v.ints != nil:
+		return float64(v.ints[i])
+	case v.uints != nil:
+		return float64(v.uints[i])
+	}
+	return v.floats[i]
+}
+
+func (v *exprVector) int(i int) int64 {
+	if v.uints != nil {
+		return int64(v.uints[i])
+	}
+	return v.ints[i]
+}
+
+func (v *exprVector) uint(i int) uint64 {
+	if v.ints != nil {
+		return uint64(v.ints[i])
+	}
+	return v.uints[i]
+}
+
+// set stores a value of the vector type in the i-th position.
+func (v *exprVector) set(i int, value interface{}) {
+	switch v.typ {
+	case series.Int:
+		v.ints[i] = int64(value.(int))
+	case series.Float:
+		v.floats[i] = value.(float64)
+	case series.String:
+		v.strs[i] = value.(string)
+	case series.Bool:
+		v.bools[i] = value.(bool)
+	case series.Time:
+		v.times[i] = value.(time.Time)
+	}
+}
+
+func (v *exprVector) series(name string) series.Series {
+	n := len(v.null)
+	values := make([]interface{}, n)
+	for i := 0; i < n; i++ {
+		if v.null[i] {
+			continue
+		}
+		switch v.typ {
+		case series.Int, series.Int64:
+			values[i] = v.ints[i]
+		case series.Int32:
+			values[i] = int32(v.ints[i])
+		case series.Uint64:
+			values[i] = v.uints[i]
+		case series.Float:
+			values[i] = v.floats[i]
+		case series.Float32:
+			values[i] = float32(v.floats[i])
+		case series.String:
+			values[i] = v.strs[i]
+		case series.Bool:
+			values[i] = v.bools[i]
+		case series.Time:
+			values[i] = v.times[i]
+		}
+	}
+	return series.New(values, v.typ, name)
+}
+
+func (n *exprNode) eval(df DataFrame) *exprVector {
+	nrows := df.nrows
+	switch n.kind {
+	case exprLiteral:
+		v := newExprVector(n.typ, nrows)
+		for i := 0; i < nrows; i++ {
+			v.set(i, n.value)
+		}
+		return v
+	case exprColumn:
+		s := df.columns[findInStringSlice(n.name, df.Names())]
+		v := newExprVector(n.typ, nrows)
+		for i := 0; i < nrows; i++ {
+			e := s.Elem(i)
+			if e.IsNull() {
+				v.null[i] = true
+				continue
+			}
+			switch n.typ {
+			case series.Int, series.Int64, series.Int32:
+				v.ints[i] = exprInt(e)
+			case series.Uint64:
+				v.uints[i], _ = e.Val().(uint64)
+			case series.Float, series.Float32:
+				v.floats[i] = e.Float()
+			case series.String:
+				v.strs[i] = e.String()
+			case series.Bool:
+				v.bools[i], _ = e.Bool()
+			case series.Time:
+				v.times[i], _ = e.Time()
+			}
+		}
+		return v
+	case exprUnary:
+		x := n.args[0].eval(df)
+		v := newExprVector(n.typ, nrows)
+		for i := 0; i < nrows; i++ {
+			v.null[i] = x.null[i]
+			switch {
+			case n.op == "!":
+				v.bools[i] = !x.bools[i]
+			case isSigned(n.typ):
+				v.ints[i] = -x.ints[i]
+			default:
+				v.floats[i] = -x.floats[i]
+			}
+		}
+		return v
+	}
+
+	x, y := n.args[0].eval(df), n.args[1].eval(df)
+	v := newExprVector(n.typ, nrows)
+	for i := 0; i < nrows; i++ {
+		switch n.op {
+		case "&&":
+			switch {
+			case !x.null[i] && !x.bools[i], !y.null[i] && !y.bools[i]:
+				v.bools[i] = false
+			case x.null[i] || y.null[i]:
+				v.null[i] = true
+			default:
+				v.bools[i] = true
+			}
+			continue
+		case "||":
+			switch {
+			case !x.null[i] && x.bools[i], !y.null[i] && y.bools[i]:
+				v.bools[i] = true
+			case x.null[i] || y.null[i]:
+				v.null[i] = true
+			default:
+				v.bools[i] = false
+			}
+			continue
+		}
+		if x.null[i] || y.null[i] {
+			v.null[i] = true
+			continue
+		}
+		switch n.op {
+		case "+", "-", "*", "/", "%":
+			evalArithmetic(n.op, x, y, v, i)
+		default:
+			v.bools[i] = evalComparison(n.op, x, y, i)
+		}
+	}
+	return v
+}
+
+func evalArithmetic(op string, x, y, v *exprVector, i int) {
+	switch v.typ {
+	case series.String:
+		v.strs[i] = x.strs[i] + y.strs[i]
+	case series.Int, series.Int64, series.Int32:
+		a, b := x.int(i), y.int(i)
+		switch op {
+		case "+":
+			v.ints[i] = a + b
+		case "-":
+			v.ints[i] = a - b
+		case "*":
+			v.ints[i] = a * b
+		case "%":
+			if b == 0 {
+				v.null[i] = true
+				return
+			}
+			v.ints[i] = a % b
+		}
+	case series.Uint64:
+		a, b := x.uint(i), y.uint(i)
+		switch op {
+		case "+":
+			v.uints[i] = a + b
+		case "-":
+			v.uints[i] = a - b
+		case "*":
+			v.uints[i] = a * b
+		case "%":
+			if b == 0 {
+				v.null[i] = true
+				return
+			}
+			v.uints[i] = a % b
+		}
+	case series.Float, series.Float32:
+		a, b := x.float(i), y.float(i)
+		switch op {
+		case "+":
+			v.floats[i] = a + b
+		case "-":
+			v.floats[i] = a - b
+		case "*":
+			v.floats[i] = a * b
+		case "/":
+			v.floats[i] = a / b
+		case "%":
+			v.floats[i] = math.Mod(a, b)
+		}
+	}
+}
+
+func evalComparison(op string, x, y *exprVector, i int) bool {
+	var c int
+	switch {
+	case x.ints != nil && y.ints != nil:
+		c = compareOrdered(x.ints[i], y.ints[i])
+	case x.uints != nil && y.uints != nil:
+		c = compareOrdered(x.uints[i], y.uints[i])
+	case x.ints != nil && y.uints != nil:
+		c = -1
+		if x.ints[i] >= 0 {
+			c = compareOrdered(uint64(x.ints[i]), y.uints[i])
+		}
+	case x.uints != nil && y.ints != nil:
+		c = 1
+		if y.ints[i] >= 0 {
+			c = compareOrdered(x.uints[i], uint64(y.ints[i]))
+		}
+	case isNumeric(x.typ):
+		a, b := x.float(i), y.float(i)
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return op == "!="
+		}
+		c = compareOrdered(a, b)
+	case x.typ == series.String:
+		c = strings.Compare(x.strs[i], y.strs[i])
+	case x.typ == series.Bool:
+		if x.bools[i] != y.bools[i] {
+			c = 1
+		}
+	case x.typ == series.Time:
+		c = x.times[i].Compare(y.times[i])
+	}
+	switch op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	default:
+		return c <= 0
+	}
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// exprInt returns the value of an element of a signed integer column.
+func exprInt(e series.Element) int64 {
+	switch v := e.Val().(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case int32:
+		return int64(v)
+	}
+	return 0
+}
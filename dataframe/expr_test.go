@@ -0,0 +1,228 @@
+package dataframe
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func exprTestDataFrame() DataFrame {
+	return New(
+		series.New([]interface{}{"NYC", "LA", "NYC", nil, "SF"}, series.String, "city"),
+		series.New([]interface{}{25, 35, 40, 50, nil}, series.Int, "age"),
+		series.New([]interface{}{0.9, 0.5, nil, 0.8, 0.2}, series.Float, "score"),
+		series.New([]interface{}{true, false, true, nil, false}, series.Bool, "active"),
+		series.New([]string{"2021-01-01", "2021-06-01", "2022-01-01", "2020-01-01", "2021-03-01"}, series.Time, "since"),
+		series.New([]int{1, 2, 0, 4, 5}, series.Int, "first name"),
+	)
+}
+
+func TestDataFrame_FilterExpr(t *testing.T) {
+	a := exprTestDataFrame()
+	table := []struct {
+		expr string
+		rows []int
+	}{
+		{"age > 30", []int{1, 2, 3}},
+		{"age > 30 && (city == 'NYC' || score >= 0.8)", []int{2, 3}},
+		{"!(age > 30)", []int{0}},
+		{"active", []int{0, 2}},
+		{"active || score > 0.85", []int{0, 2}},
+		{"!active && score < 0.6", []int{1, 4}},
+		{"city != \"NYC\"", []int{1, 4}},
+		{"since >= '2021-03-01' && since < '2022-01-01'", []int{1, 4}},
+		{"age * 2 - 10 >= 70 || -age == -25", []int{0, 2, 3}},
+		{"age % 20 == 5", []int{0}},
+		{"`first name` * 20.0 > age", []int{1, 3}},
+		{"city + '!' == 'LA!'", []int{1}},
+		{"active == true", []int{0, 2}},
+		{"age\u00a0>\u200330", []int{1, 2, 3}},
+		{"false", []int{}},
+	}
+	for i, tc := range table {
+		b := a.FilterExpr(tc.expr)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		expDf := a.Subset(tc.rows)
+		if len(tc.rows) == 0 {
+			expDf = a.Subset([]bool{false, false, false, false, false})
+		}
+		if !reflect.DeepEqual(expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, expDf.Records(), b.Records())
+		}
+	}
+}
+
+func TestDataFrame_Filter_Expr(t *testing.T) {
+	a := exprTestDataFrame()
+	table := []struct {
+		df   DataFrame
+		rows []int
+	}{
+		{a.Filter(Expr("age > 30")), []int{1, 2, 3}},
+		{a.Filter(Expr("age < 30"), F{Colname: "city", Comparator: series.Eq, Comparando: "SF"}), []int{0, 4}},
+		{a.FilterAggregation(And, Expr("age > 30"), F{Colname: "city", Comparator: series.Eq, Comparando: "NYC"}), []int{2}},
+	}
+	for i, tc := range table {
+		if tc.df.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, tc.df.Err)
+			continue
+		}
+		expDf := a.Subset(tc.rows)
+		if !reflect.DeepEqual(expDf.Records(), tc.df.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, expDf.Records(), tc.df.Records())
+		}
+	}
+	if b := a.Filter(Expr("age +")); b.Err == nil {
+		t.Errorf("Expected error on invalid expression")
+	}
+}
+
+func TestDataFrame_MutateExpr(t *testing.T) {
+	a := exprTestDataFrame()
+	table := []struct {
+		colname   string
+		expr      string
+		expSeries series.Series
+	}{
+		{
+			"ratio",
+			"score / age",
+			series.New([]interface{}{0.9 / 25, 0.5 / 35, nil, 0.8 / 50, nil}, series.Float, "ratio"),
+		},
+		{
+			"age",
+			"age + 1",
+			series.New([]interface{}{26, 36, 41, 51, nil}, series.Int, "age"),
+		},
+		{
+			"mod",
+			"age % `first name`",
+			series.New([]interface{}{0, 1, nil, 2, nil}, series.Int, "mod"),
+		},
+		{
+			"any",
+			"active || age > 45",
+			series.New([]interface{}{true, false, true, true, nil}, series.Bool, "any"),
+		},
+		{
+			"both",
+			"active && age > 45",
+			series.New([]interface{}{false, false, false, nil, false}, series.Bool, "both"),
+		},
+		{
+			"half",
+			"-(1 / 2) * 1.5e1",
+			series.New([]float64{-7.5, -7.5, -7.5, -7.5, -7.5}, series.Float, "half"),
+		},
+	}
+	for i, tc := range table {
+		b := a.MutateExpr(tc.colname, tc.expr)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		s := b.Col(tc.colname)
+		if s.Type() != tc.expSeries.Type() {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expSeries.Type(), s.Type())
+		}
+		if !reflect.DeepEqual(tc.expSeries.Records(), s.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expSeries.Records(), s.Records())
+		}
+		if !reflect.DeepEqual(tc.expSeries.IsNull(), s.IsNull()) {
+			t.Errorf("Test: %d\nDifferent nulls:\nA:%v\nB:%v", i, tc.expSeries.IsNull(), s.IsNull())
+		}
+	}
+
+	nan := New(series.New([]float64{math.NaN(), 1}, series.Float, "x"))
+	if b := nan.FilterExpr("x != 1"); b.Nrow() != 1 {
+		t.Errorf("Expected NaN != 1 to be true")
+	}
+}
+
+func TestDataFrame_Expr_Errors(t *testing.T) {
+	a := exprTestDataFrame()
+	for i, expr := range []string{
+		"",
+		"age >",
+		"age > 30 )",
+		"(age > 30",
+		"age > 30 > 20",
+		"missing > 1",
+		"city > 1",
+		"age && active",
+		"!age",
+		"-city",
+		"city - 'a'",
+		"active > false",
+		"since > 'not a time'",
+		"'unterminated",
+		"age # 2",
+		"1.2.3",
+		"age > 30\xa0",
+		"age > 30\x85",
+		"é > 1",
+	} {
+		if b := a.FilterExpr(expr); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error on %q", i, expr)
+		}
+	}
+	if b := a.FilterExpr("age + 1"); b.Err == nil {
+		t.Errorf("Expected error on non bool filter")
+	}
+	if b := a.MutateExpr("x", "age + city"); b.Err == nil {
+		t.Errorf("Expected error on mutate")
+	}
+}
+
+func TestDataFrame_MutateExpr_Sized(t *testing.T) {
+	a := New(
+		series.New([]int32{1, 2, 3}, series.Int32, "i32"),
+		series.New([]int64{1 << 40, 2, 3}, series.Int64, "i64"),
+		series.New([]uint64{18446744073709551615, 2, 3}, series.Uint64, "u64"),
+		series.New([]float32{0.5, 1.5, 2.5}, series.Float32, "f32"),
+		series.New([]int{1, 2, 3}, series.Int, "int"),
+	)
+	table := []struct {
+		expr     string
+		t        series.Type
+		expected []string
+	}{
+		{"i32 * 2", series.Int32, []string{"2", "4", "6"}},
+		{"i32 + int", series.Int, []string{"2", "4", "6"}},
+		{"i32 + i64", series.Int64, []string{"1099511627777", "4", "6"}},
+		{"u64 % 10", series.Uint64, []string{"5", "2", "3"}},
+		{"u64 + int", series.Float, []string{"18446744073709551616.000000", "4.000000", "6.000000"}},
+		{"f32 * 2.0", series.Float32, []string{"1.000000", "3.000000", "5.000000"}},
+		{"f32 + i32", series.Float, []string{"1.500000", "3.500000", "5.500000"}},
+		{"f32 + i64", series.Float, []string{"1099511627776.500000", "3.500000", "5.500000"}},
+		{"i32 / 2", series.Float, []string{"0.500000", "1.000000", "1.500000"}},
+		{"-i64", series.Int64, []string{"-1099511627776", "-2", "-3"}},
+	}
+	for i, tc := range table {
+		b := a.MutateExpr("x", tc.expr)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		s := b.Col("x")
+		if s.Type() != tc.t {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.t, s.Type())
+		}
+		if !reflect.DeepEqual(tc.expected, s.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expected, s.Records())
+		}
+	}
+
+	b := a.FilterExpr("u64 >= i64 && i32 >= 2")
+	if expected := []string{"2", "3"}; !reflect.DeepEqual(expected, b.Col("i32").Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, b.Col("i32").Records())
+	}
+	if b := a.MutateExpr("x", "-u64"); b.Err == nil {
+		t.Errorf("Expected error negating Uint64")
+	}
+}
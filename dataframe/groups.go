@@ -0,0 +1,268 @@
+package dataframe
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// AggFunc is a custom aggregation for Groups.Agg. F reduces the DataFrame of a
+// group to a single value, which is stored in the column Name with the given
+// Type. The value can be of any type accepted by series.New, including a
+// series.Element, and nil is stored as a missing element. If Type is empty, it
+// is taken from the value returned for the first group.
+type AggFunc struct {
+	Name string
+	Type series.Type
+	F    func(DataFrame) interface{}
+}
+
+// AggCol returns an AggFunc that reduces the column colname of every group with
+// f, storing the result in the column name with type t.
+func AggCol(name, colname string, t series.Type, f func(series.Series) interface{}) AggFunc {
+	return AggFunc{
+		Name: name,
+		Type: t,
+		F: func(df DataFrame) interface{} {
+			return f(df.Col(colname))
+		},
+	}
+}
+
+// Agg aggregates every group with the given custom aggregations. The result has
+// the grouping columns first, followed by one column per aggregation, and one
+// row per group.
+func (gps Groups) Agg(aggs ...AggFunc) DataFrame {
+	if gps.Err != nil {
+		return DataFrame{Err: gps.Err}
+	}
+	if gps.groups == nil {
+		return DataFrame{Err: fmt.Errorf("agg: input is nil")}
+	}
+	columns := gps.keyColumns()
+	for _, agg := range aggs {
+		if agg.F == nil {
+			return DataFrame{Err: fmt.Errorf("agg: nil function for column %s", agg.Name)}
+		}
+		t := agg.Type
+		values := make([]interface{}, len(gps.keys))
+		for i, key := range gps.keys {
+			value := agg.F(gps.groups[key.id])
+			if t == "" && value != nil {
+				t = typeOfValue(value)
+			}
+			values[i] = value
+		}
+		if t == "" {
+			t = series.String
+		}
+		s := series.New(values, t, agg.Name)
+		if s.Err != nil {
+			return DataFrame{Err: fmt.Errorf("agg: column %s: %v", agg.Name, s.Err)}
+		}
+		columns = append(columns, s)
+	}
+	return New(columns...)
+}
+
+// Apply calls f with the DataFrame of every group and binds the returned
+// DataFrames by rows, in the order of the groups. All of them must have the
+// same columns. If the grouping columns are missing from the result of f, they
+// are added at the beginning with the key of the group. Groups for which f
+// returns a DataFrame without rows are left out.
+func (gps Groups) Apply(f func(DataFrame) DataFrame) DataFrame {
+	if gps.Err != nil {
+		return DataFrame{Err: gps.Err}
+	}
+	if gps.groups == nil {
+		return DataFrame{Err: fmt.Errorf("apply: input is nil")}
+	}
+	var res DataFrame
+	first := true
+	for _, key := range gps.keys {
+		df := f(gps.groups[key.id])
+		if df.Err != nil {
+			return DataFrame{Err: fmt.Errorf("apply: %v", df.Err)}
+		}
+		if df.Nrow() == 0 {
+			continue
+		}
+		var keyColumns []series.Series
+		for j, c := range gps.colnames {
+			if findInStringSlice(c, df.Names()) != -1 {
+				continue
+			}
+			elements := make([]series.Element, df.Nrow())
+			for i := range elements {
+				elements[i] = key.values[j]
+			}
+			keyColumns = append(keyColumns, series.New(elements, key.values[j].Type(), c))
+		}
+		if keyColumns != nil {
+			df = New(keyColumns...).CBind(df)
+		}
+		if first {
+			res = df
+			first = false
+			continue
+		}
+		if len(df.Names()) != len(res.Names()) {
+			return DataFrame{Err: fmt.Errorf("apply: column names are not compatible")}
+		}
+		res = res.RBind(df)
+		if res.Err != nil {
+			return DataFrame{Err: fmt.Errorf("apply: %v", res.Err)}
+		}
+	}
+	if first {
+		return DataFrame{Err: fmt.Errorf("apply: empty DataFrame")}
+	}
+	return res
+}
+
+// TransformFunc is a custom transformation for Groups.Transform. F maps the
+// DataFrame of a group to a Series with one element per row of the group,
+// which is stored in the column Name.
+type TransformFunc struct {
+	Name string
+	F    func(DataFrame) series.Series
+}
+
+// TransformCol returns a TransformFunc that maps the column colname of every
+// group with f, storing the result in the column name. Series methods such as
+// Shift, CumSum or Rank can be used as f through method values or closures.
+func TransformCol(name, colname string, f func(series.Series) series.Series) TransformFunc {
+	return TransformFunc{
+		Name: name,
+		F: func(df DataFrame) series.Series {
+			return f(df.Col(colname))
+		},
+	}
+}
+
+// Transform applies the given transformations to every group and returns the
+// grouped DataFrame with the results as new columns, aligned with its original
+// rows. Columns with the same name as a transformation are replaced. The type
+// of every new column is the type returned for the first group, and rows that
+// don't belong to any group get missing values.
+func (gps Groups) Transform(transforms ...TransformFunc) DataFrame {
+	if gps.Err != nil {
+		return DataFrame{Err: gps.Err}
+	}
+	if gps.groups == nil {
+		return DataFrame{Err: fmt.Errorf("transform: input is nil")}
+	}
+	res := gps.df.Copy()
+	for _, transform := range transforms {
+		if transform.F == nil {
+			return DataFrame{Err: fmt.Errorf("transform: nil function for column %s", transform.Name)}
+		}
+		var t series.Type
+		values := make([]interface{}, gps.df.nrows)
+		for _, key := range gps.keys {
+			s := transform.F(gps.groups[key.id])
+			if s.Err != nil {
+				return DataFrame{Err: fmt.Errorf("transform: column %s: %v", transform.Name, s.Err)}
+			}
+			if s.Len() != len(key.rows) {
+				return DataFrame{Err: fmt.Errorf("transform: column %s: wrong dimensions", transform.Name)}
+			}
+			if t == "" {
+				t = s.Type()
+			}
+			for k, i := range key.rows {
+				values[i] = s.Elem(k)
+			}
+		}
+		if t == "" {
+			t = series.Float
+		}
+		res = res.Mutate(series.New(values, t, transform.Name))
+		if res.Err != nil {
+			return DataFrame{Err: fmt.Errorf("transform: %v", res.Err)}
+		}
+	}
+	return res
+}
+
+// SortByKey returns the groups ordered by the values of their keys, comparing
+// the grouping columns in order. Missing values are placed last.
+func (gps Groups) SortByKey() *Groups {
+	if gps.Err != nil {
+		return &gps
+	}
+	keys := make([]groupKey, len(gps.keys))
+	copy(keys, gps.keys)
+	sort.SliceStable(keys, func(i, j int) bool {
+		for k := range gps.colnames {
+			a, b := keys[i].values[k], keys[j].values[k]
+			switch {
+			case a.IsNull() && b.IsNull():
+				continue
+			case a.IsNull():
+				return false
+			case b.IsNull():
+				return true
+			case a.Less(b):
+				return true
+			case a.Greater(b):
+				return false
+			}
+		}
+		return false
+	})
+	gps.keys = keys
+	return &gps
+}
+
+// keyColumns returns the grouping columns with one row per group.
+func (gps Groups) keyColumns() []series.Series {
+	columns := make([]series.Series, 0, len(gps.colnames))
+	for j, c := range gps.colnames {
+		elements := make([]series.Element, len(gps.keys))
+		for i, key := range gps.keys {
+			elements[i] = key.values[j]
+		}
+		t := series.String
+		i := gps.df.colIndex(c)
+		if i >= 0 {
+			t = gps.df.columns[i].Type()
+		}
+		if t == series.Categorical {
+			// Keep the categories of the grouping column
+			col := gps.df.columns[i].Empty()
+			col.Append(elements)
+			columns = append(columns, col)
+			continue
+		}
+		columns = append(columns, series.New(elements, t, c))
+	}
+	return columns
+}
+
+// typeOfValue returns the type of the Series that best stores the given value.
+func typeOfValue(value interface{}) series.Type {
+	switch v := value.(type) {
+	case series.Element:
+		return v.Type()
+	case int:
+		return series.Int
+	case float64:
+		return series.Float
+	case int64:
+		return series.Int64
+	case int32:
+		return series.Int32
+	case uint64:
+		return series.Uint64
+	case float32:
+		return series.Float32
+	case bool:
+		return series.Bool
+	case time.Time:
+		return series.Time
+	}
+	return series.String
+}
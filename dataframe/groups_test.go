@@ -0,0 +1,303 @@
+package dataframe
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestGroups_Agg(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "b", "a", "b"}, series.String, "key"),
+		series.New([]string{"x", "y", "x", "z", "w"}, series.String, "tag"),
+		series.New([]float64{1, 2, 3, 4, 5}, series.Float, "value"),
+		series.New([]int{1, 1, 2, 3, 1}, series.Int, "weight"),
+	)
+	groups := a.GroupBy("key").SortByKey()
+	b := groups.Agg(
+		AggCol("first_tag", "tag", series.String, func(s series.Series) interface{} {
+			return s.Elem(0)
+		}),
+		AggCol("last_value", "value", "", func(s series.Series) interface{} {
+			return s.Elem(s.Len() - 1)
+		}),
+		AggCol("tags", "tag", series.Int, func(s series.Series) interface{} {
+			unique := map[string]bool{}
+			for _, v := range s.Records() {
+				unique[v] = true
+			}
+			return len(unique)
+		}),
+		AggCol("joined", "tag", "", func(s series.Series) interface{} {
+			return strings.Join(s.Records(), "|")
+		}),
+		AggFunc{
+			Name: "wmean",
+			Type: series.Float,
+			F: func(df DataFrame) interface{} {
+				values := df.Col("value").Float()
+				weights := df.Col("weight").Float()
+				var sum, total float64
+				for i := range values {
+					sum += values[i] * weights[i]
+					total += weights[i]
+				}
+				return sum / total
+			},
+		},
+		AggFunc{
+			Name: "none",
+			F: func(df DataFrame) interface{} {
+				return nil
+			},
+		},
+	)
+	expDf := New(
+		series.New([]string{"a", "b"}, series.String, "key"),
+		series.New([]string{"y", "x"}, series.String, "first_tag"),
+		series.New([]float64{4, 5}, series.Float, "last_value"),
+		series.New([]int{2, 2}, series.Int, "tags"),
+		series.New([]string{"y|z", "x|x|w"}, series.String, "joined"),
+		series.New([]float64{14.0 / 4, 12.0 / 4}, series.Float, "wmean"),
+		series.New([]interface{}{nil, nil}, series.String, "none"),
+	)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	if !reflect.DeepEqual(expDf.Types(), b.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expDf.Types(), b.Types())
+	}
+	if !reflect.DeepEqual(expDf.Records(), b.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expDf.Records(), b.Records())
+	}
+
+	if b := groups.Agg(AggFunc{Name: "nil"}); b.Err == nil {
+		t.Errorf("Expected error on nil function")
+	}
+	if b := (&Groups{}).Agg(); b.Err == nil {
+		t.Errorf("Expected error on empty groups")
+	}
+
+	// Without groups, the key columns keep their types
+	empty := a.Filter(F{Colname: "value", Comparator: series.Greater, Comparando: 10})
+	b = empty.GroupBy("key", "weight").Agg(AggCol("n", "value", series.Int, func(s series.Series) interface{} {
+		return s.Len()
+	}))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	if expected := []series.Type{series.String, series.Int, series.Int}; !reflect.DeepEqual(expected, b.Types()) || b.Nrow() != 0 {
+		t.Errorf("Expected 0 rows of types %v, got %d of %v", expected, b.Nrow(), b.Types())
+	}
+}
+
+func TestGroups_Apply(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "b", "a", "b"}, series.String, "key"),
+		series.New([]int{1, 2, 1, 2, 2}, series.Int, "num"),
+		series.New([]float64{1, 2, 3, 4, 5}, series.Float, "value"),
+	)
+	table := []struct {
+		groups *Groups
+		f      func(DataFrame) DataFrame
+		expDf  DataFrame
+	}{
+		{
+			a.GroupBy("key"),
+			func(df DataFrame) DataFrame {
+				return df.Arrange(RevSort("value")).Subset([]int{0})
+			},
+			New(
+				series.New([]string{"b", "a"}, series.String, "key"),
+				series.New([]int{2, 2}, series.Int, "num"),
+				series.New([]float64{5, 4}, series.Float, "value"),
+			),
+		},
+		{
+			a.GroupBy("key", "num"),
+			func(df DataFrame) DataFrame {
+				return New(series.New([]float64{df.Col("value").Sum()}, series.Float, "total"))
+			},
+			New(
+				series.New([]string{"b", "a", "b"}, series.String, "key"),
+				series.New([]int{1, 2, 2}, series.Int, "num"),
+				series.New([]float64{4, 6, 5}, series.Float, "total"),
+			),
+		},
+		{
+			a.GroupBy("key"),
+			func(df DataFrame) DataFrame {
+				return df.FilterExpr("value > 2").Select([]string{"value"})
+			},
+			New(
+				series.New([]string{"b", "b", "a"}, series.String, "key"),
+				series.New([]float64{3, 5, 4}, series.Float, "value"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := tc.groups.Apply(tc.f)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expDf.Names(), b.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, tc.expDf.Names(), b.Names())
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), b.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), b.Records())
+		}
+	}
+
+	groups := a.GroupBy("key")
+	i := 0
+	b := groups.Apply(func(df DataFrame) DataFrame {
+		i++
+		if i == 1 {
+			return df.Select([]string{"value"})
+		}
+		return df.Select([]string{"num"})
+	})
+	if b.Err == nil {
+		t.Errorf("Expected error on incompatible columns")
+	}
+}
+
+func TestGroups_Transform(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "b", "a", "b"}, series.String, "customer"),
+		series.New([]interface{}{10, 5, nil, 7, 3}, series.Int, "amount"),
+	)
+	b := a.GroupBy("customer").Transform(
+		TransformCol("running", "amount", series.Series.CumSum),
+		TransformCol("previous", "amount", func(s series.Series) series.Series {
+			return s.Shift(1)
+		}),
+		TransformCol("row", "amount", func(s series.Series) series.Series {
+			return series.Ints(make([]int, s.Len())).Rank(series.RankFirst, false)
+		}),
+		TransformCol("amount", "amount", func(s series.Series) series.Series {
+			return s.Rank(series.RankMin, true)
+		}),
+	)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := New(
+		series.New([]string{"b", "a", "b", "a", "b"}, series.String, "customer"),
+		series.New([]interface{}{1, 2, nil, 1, 2}, series.Int, "amount"),
+		series.New([]interface{}{10, 5, nil, 12, 13}, series.Float, "running"),
+		series.New([]interface{}{nil, nil, 10, 5, nil}, series.Int, "previous"),
+		series.New([]int{1, 1, 2, 2, 3}, series.Int, "row"),
+	)
+	if !reflect.DeepEqual(expected.Names(), b.Names()) {
+		t.Errorf("Different colnames:\nA:%v\nB:%v", expected.Names(), b.Names())
+	}
+	if !reflect.DeepEqual(expected.Types(), b.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expected.Types(), b.Types())
+	}
+	if !reflect.DeepEqual(expected.Records(), b.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected.Records(), b.Records())
+	}
+	for _, c := range expected.Names() {
+		if exp, got := expected.Col(c).IsNull(), b.Col(c).IsNull(); !reflect.DeepEqual(exp, got) {
+			t.Errorf("Different nulls on %s:\nA:%v\nB:%v", c, exp, got)
+		}
+	}
+
+	// Errors
+	for i, df := range []DataFrame{
+		a.GroupBy("customer").Transform(TransformCol("x", "amount", func(s series.Series) series.Series {
+			return series.Ints([]int{1})
+		})),
+		a.GroupBy("customer").Transform(TransformCol("x", "customer", series.Series.CumSum)),
+		a.GroupBy("customer").Transform(TransformFunc{Name: "x"}),
+		a.GroupBy("unknown").Transform(),
+	} {
+		if df.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
+
+func TestGroups_Transform_Resample(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := New(
+		series.New([]interface{}{base, base.Add(90 * time.Minute), nil, base.Add(10 * time.Minute)}, series.Time, "time"),
+		series.New([]float64{1, 2, 3, 4}, series.Float, "value"),
+	)
+	b := a.Resample("time", "1h").Transform(TransformCol("total", "value", series.Series.CumSum))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := series.New([]interface{}{1, 2, nil, 5}, series.Float, "total")
+	if got := b.Col("total"); !reflect.DeepEqual(expected.Records(), got.Records()) ||
+		!reflect.DeepEqual(expected.IsNull(), got.IsNull()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, got)
+	}
+}
+
+func TestGroups_Transform_Windows(t *testing.T) {
+	a := New(
+		series.New([]string{"a", "b", "a", "b", "a"}, series.String, "host"),
+		series.New([]interface{}{1, 10, nil, 20, 3}, series.Int, "latency"),
+	)
+	b := a.GroupBy("host").Transform(
+		TransformCol("smoothed", "latency", func(s series.Series) series.Series {
+			return s.EWM(series.Alpha(0.5)).Mean()
+		}),
+		TransformCol("peak", "latency", func(s series.Series) series.Series {
+			return s.Expanding().Max()
+		}),
+	)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := map[string][]string{
+		"smoothed": {"1.000000", "10.000000", "1.000000", "16.666667", "2.600000"},
+		"peak":     {"1.000000", "10.000000", "1.000000", "20.000000", "3.000000"},
+	}
+	for c, exp := range expected {
+		if got := b.Col(c).Records(); !reflect.DeepEqual(exp, got) {
+			t.Errorf("Different values on %s:\nA:%v\nB:%v", c, exp, got)
+		}
+	}
+}
+
+func TestGroups_Categorical(t *testing.T) {
+	a := New(
+		series.NewCategorical([]string{"low", "high", "mid", "low", "NaN"}, []string{"low", "mid", "high"}, "level"),
+		series.New([]float64{1, 2, 3, 4, 5}, series.Float, "value"),
+	)
+	b := a.GroupBy("level").SortByKey().Agg(
+		AggCol("sum", "value", series.Float, func(s series.Series) interface{} {
+			return s.Sum()
+		}),
+	)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := [][]string{
+		{"level", "sum"},
+		{"low", "5.000000"},
+		{"mid", "3.000000"},
+		{"high", "2.000000"},
+		{"NaN", "5.000000"},
+	}
+	if !reflect.DeepEqual(expected, b.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, b.Records())
+	}
+	level := b.Col("level")
+	if level.Type() != series.Categorical {
+		t.Errorf("Expected type %v, got %v", series.Categorical, level.Type())
+	}
+	if exp := []string{"low", "mid", "high"}; !reflect.DeepEqual(exp, level.Categories()) {
+		t.Errorf("Different categories:\nA:%v\nB:%v", exp, level.Categories())
+	}
+}
@@ -0,0 +1,220 @@
+package dataframe
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-gota/gota/series"
+)
+
+// SetIndex returns a copy of the DataFrame indexed by the given columns. The
+// index columns are kept as regular columns, and their values are used as row
+// labels by Loc and to align rows on CBind. The index is preserved by Subset,
+// Arrange, Filter, Copy, Rename, Select, Drop, Mutate, CBind and RBind, as
+// long as its columns are kept; other operations return DataFrames without
+// index.
+func (df DataFrame) SetIndex(colnames ...string) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if len(colnames) == 0 {
+		return DataFrame{Err: fmt.Errorf("set index: no column names")}
+	}
+	for _, c := range colnames {
+		if findInStringSlice(c, df.Names()) == -1 {
+			return DataFrame{Err: fmt.Errorf("set index: can't find column name: %s", c)}
+		}
+	}
+	df = df.Copy()
+	df.index = append([]string(nil), colnames...)
+	return df
+}
+
+// Index returns the names of the index columns of the DataFrame, or nil if it
+// has no index.
+func (df DataFrame) Index() []string {
+	if df.index == nil {
+		return nil
+	}
+	return append([]string(nil), df.index...)
+}
+
+// ResetIndex returns a copy of the DataFrame without index. The index columns
+// are kept as regular columns.
+func (df DataFrame) ResetIndex() DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	df = df.Copy()
+	df.index = nil
+	return df
+}
+
+// Loc returns the rows of the DataFrame with the given index labels, in the
+// order of the labels. Every row matching a label is returned. For DataFrames
+// indexed by several columns, each label must be a []interface{} with one
+// value per index column. Labels are converted to the type of the index
+// columns before matching.
+func (df DataFrame) Loc(labels ...interface{}) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if df.index == nil {
+		return DataFrame{Err: fmt.Errorf("loc: DataFrame has no index")}
+	}
+	tuples, err := df.indexTuples(df)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("loc: %v", err)}
+	}
+	rows := make(map[string][]int)
+	for i, tuple := range tuples {
+		rows[tuple] = append(rows[tuple], i)
+	}
+
+	var idx []int
+	for _, label := range labels {
+		values := []interface{}{label}
+		if len(df.index) > 1 {
+			tuple, ok := label.([]interface{})
+			if !ok || len(tuple) != len(df.index) {
+				return DataFrame{Err: fmt.Errorf("loc: label %v must have %d values", label, len(df.index))}
+			}
+			values = tuple
+		}
+		elements := make([]series.Element, len(values))
+		for j, v := range values {
+			s := series.New([]interface{}{v}, df.Col(df.index[j]).Type(), "")
+			if s.Err != nil {
+				return DataFrame{Err: fmt.Errorf("loc: %v", s.Err)}
+			}
+			elements[j] = s.Elem(0)
+		}
+		matches, ok := rows[groupTuple(elements)]
+		if !ok {
+			return DataFrame{Err: fmt.Errorf("loc: label not found: %v", label)}
+		}
+		idx = append(idx, matches...)
+	}
+	return df.Subset(idx)
+}
+
+// indexTuples returns the encoded index labels of the rows of dfb, with the
+// index values converted to the types of the index of df.
+func (df DataFrame) indexTuples(dfb DataFrame) ([]string, error) {
+	columns := make([]series.Series, len(df.index))
+	for j := range df.index {
+		col := dfb.Col(dfb.index[j])
+		if col.Err != nil {
+			return nil, col.Err
+		}
+		t := df.Col(df.index[j])
+		if t.Err != nil {
+			return nil, t.Err
+		}
+		if col.Type() != t.Type() {
+			col = series.New(col, t.Type(), col.Name)
+		}
+		columns[j] = col
+	}
+	tuples := make([]string, dfb.nrows)
+	values := make([]series.Element, len(columns))
+	for i := range tuples {
+		for j, col := range columns {
+			values[j] = col.Elem(i)
+		}
+		tuples[i] = groupTuple(values)
+	}
+	return tuples, nil
+}
+
+// checkIndexOverlap returns an error if the rows of dfb can't be appended to
+// df keeping the index, because their index columns are different or they
+// have labels in common.
+func (df DataFrame) checkIndexOverlap(dfb DataFrame) error {
+	if !reflect.DeepEqual(df.index, dfb.index) {
+		return fmt.Errorf("indexes have different columns: %v and %v", df.index, dfb.index)
+	}
+	tuples, err := df.indexTuples(df)
+	if err != nil {
+		return err
+	}
+	tuplesb, err := df.indexTuples(dfb)
+	if err != nil {
+		return err
+	}
+	labels := make(map[string]struct{}, len(tuples))
+	for _, tuple := range tuples {
+		labels[tuple] = struct{}{}
+	}
+	for i, tuple := range tuplesb {
+		if _, ok := labels[tuple]; ok {
+			return fmt.Errorf("index label of row %d is already in the DataFrame", i)
+		}
+	}
+	return nil
+}
+
+// checkIndex removes the index of the DataFrame if any of its columns is
+// missing.
+func (df DataFrame) checkIndex() DataFrame {
+	names := df.Names()
+	for _, c := range df.index {
+		if findInStringSlice(c, names) == -1 {
+			df.index = nil
+			break
+		}
+	}
+	return df
+}
+
+// alignedCBind combines the columns of two indexed DataFrames, matching the
+// rows of dfb to the index labels of df. Labels of df not in dfb get missing
+// values, and rows of dfb with labels not in df are dropped. The index columns
+// of dfb are not added again.
+func (df DataFrame) alignedCBind(dfb DataFrame) DataFrame {
+	if len(df.index) != len(dfb.index) {
+		return DataFrame{Err: fmt.Errorf("cbind: indexes have different number of columns")}
+	}
+	tuplesb, err := df.indexTuples(dfb)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("cbind: %v", err)}
+	}
+	tuples, err := df.indexTuples(df)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("cbind: %v", err)}
+	}
+	positions := make(map[string]int, dfb.nrows)
+	for i, tuple := range tuplesb {
+		if _, ok := positions[tuple]; ok {
+			return DataFrame{Err: fmt.Errorf("cbind: duplicated index label on row %d", i)}
+		}
+		positions[tuple] = i
+	}
+	match := make([]int, df.nrows)
+	for i, tuple := range tuples {
+		match[i] = -1
+		if j, ok := positions[tuple]; ok {
+			match[i] = j
+		}
+	}
+
+	columns := make([]series.Series, 0, df.ncols+dfb.ncols)
+	columns = append(columns, df.columns...)
+	for _, col := range dfb.columns {
+		if findInStringSlice(col.Name, dfb.index) != -1 {
+			continue
+		}
+		values := make([]interface{}, df.nrows)
+		for i, j := range match {
+			if j != -1 {
+				values[i] = col.Elem(j)
+			}
+		}
+		columns = append(columns, series.New(values, col.Type(), col.Name))
+	}
+	res := New(columns...)
+	if res.Err == nil {
+		res.index = df.index
+	}
+	return res
+}
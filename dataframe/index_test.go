@@ -0,0 +1,245 @@
+package dataframe
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_SetIndex(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "c", "a"}, series.String, "id"),
+		series.New([]int{1, 2, 1, 1}, series.Int, "n"),
+		series.New([]float64{1.5, 2.5, 3.5, 4.5}, series.Float, "value"),
+	)
+	b := a.SetIndex("id")
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	if !reflect.DeepEqual([]string{"id"}, b.Index()) {
+		t.Errorf("Different index:\nA:%v\nB:%v", []string{"id"}, b.Index())
+	}
+	if a.Index() != nil {
+		t.Errorf("Expected original DataFrame without index")
+	}
+
+	// The index is preserved through Subset, Arrange, Filter and Copy.
+	for i, df := range []DataFrame{
+		b.Subset([]int{0, 1}),
+		b.Arrange(Sort("value")),
+		b.Filter(F{Colname: "n", Comparator: series.Eq, Comparando: 1}),
+		b.FilterExpr("value > 2"),
+		b.Copy(),
+	} {
+		if df.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, df.Err)
+		}
+		if !reflect.DeepEqual([]string{"id"}, df.Index()) {
+			t.Errorf("Test: %d\nDifferent index:\nA:%v\nB:%v", i, []string{"id"}, df.Index())
+		}
+	}
+
+	c := b.ResetIndex()
+	if c.Index() != nil {
+		t.Errorf("Expected DataFrame without index")
+	}
+	if !reflect.DeepEqual(a.Records(), c.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", a.Records(), c.Records())
+	}
+
+	if b := a.SetIndex("missing"); b.Err == nil {
+		t.Errorf("Expected error on missing column")
+	}
+	if b := a.SetIndex(); b.Err == nil {
+		t.Errorf("Expected error on no columns")
+	}
+}
+
+func TestDataFrame_Loc(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "c", "a"}, series.String, "id"),
+		series.New([]int{1, 2, 1, 1}, series.Int, "n"),
+		series.New([]float64{1.5, 2.5, 3.5, 4.5}, series.Float, "value"),
+	)
+	table := []struct {
+		df     DataFrame
+		labels []interface{}
+		rows   []int
+	}{
+		{a.SetIndex("id"), []interface{}{"c", "b"}, []int{2, 0}},
+		{a.SetIndex("id"), []interface{}{"a"}, []int{1, 3}},
+		{a.SetIndex("n"), []interface{}{2, "1"}, []int{1, 0, 2, 3}},
+		{a.SetIndex("value"), []interface{}{3.5}, []int{2}},
+		{a.SetIndex("id", "n"), []interface{}{[]interface{}{"a", 1}, []interface{}{"c", 1}}, []int{3, 2}},
+	}
+	for i, tc := range table {
+		b := tc.df.Loc(tc.labels...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		expDf := a.Subset(tc.rows)
+		if !reflect.DeepEqual(expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, expDf.Records(), b.Records())
+		}
+		if !reflect.DeepEqual(tc.df.Index(), b.Index()) {
+			t.Errorf("Test: %d\nDifferent index:\nA:%v\nB:%v", i, tc.df.Index(), b.Index())
+		}
+	}
+
+	for i, df := range []DataFrame{
+		a.Loc("a"),
+		a.SetIndex("id").Loc("z"),
+		a.SetIndex("id", "n").Loc("a"),
+		a.SetIndex("id", "n").Loc([]interface{}{"a"}),
+	} {
+		if df.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
+
+func TestDataFrame_CBind_Index(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a", "c"}, series.String, "id"),
+		series.New([]int{1, 2, 3}, series.Int, "n"),
+	).SetIndex("id")
+	b := New(
+		series.New([]string{"a", "d", "b"}, series.String, "key"),
+		series.New([]float64{0.5, 1.5, 2.5}, series.Float, "value"),
+		series.New([]bool{true, false, true}, series.Bool, "flag"),
+	).SetIndex("key")
+
+	c := a.CBind(b)
+	expDf := New(
+		series.New([]string{"b", "a", "c"}, series.String, "id"),
+		series.New([]int{1, 2, 3}, series.Int, "n"),
+		series.New([]interface{}{2.5, 0.5, nil}, series.Float, "value"),
+		series.New([]interface{}{true, true, nil}, series.Bool, "flag"),
+	)
+	if c.Err != nil {
+		t.Fatalf("Error: %v", c.Err)
+	}
+	if !reflect.DeepEqual(expDf.Types(), c.Types()) {
+		t.Errorf("Different types:\nA:%v\nB:%v", expDf.Types(), c.Types())
+	}
+	if !reflect.DeepEqual(expDf.Records(), c.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expDf.Records(), c.Records())
+	}
+	if !reflect.DeepEqual([]string{"id"}, c.Index()) {
+		t.Errorf("Different index:\nA:%v\nB:%v", []string{"id"}, c.Index())
+	}
+
+	// Without index on both sides, columns are combined by position.
+	if c := a.CBind(b.ResetIndex()); c.Ncol() != 5 || c.Index() != nil {
+		t.Errorf("Expected positional CBind")
+	}
+
+	dup := New(series.New([]string{"a", "a"}, series.String, "key")).SetIndex("key")
+	if c := a.CBind(dup); c.Err == nil {
+		t.Errorf("Expected error on duplicated labels")
+	}
+	multi := New(
+		series.New([]string{"a"}, series.String, "k1"),
+		series.New([]string{"a"}, series.String, "k2"),
+	).SetIndex("k1", "k2")
+	if c := a.CBind(multi); c.Err == nil {
+		t.Errorf("Expected error on different index sizes")
+	}
+}
+
+func TestDataFrame_RBind_Index(t *testing.T) {
+	a := New(
+		series.New([]string{"b", "a"}, series.String, "id"),
+		series.New([]int{1, 2}, series.Int, "n"),
+	)
+	b := New(
+		series.New([]string{"c"}, series.String, "id"),
+		series.New([]int{3}, series.Int, "n"),
+	)
+	c := a.SetIndex("id").RBind(b.SetIndex("id"))
+	if !reflect.DeepEqual([]string{"id"}, c.Index()) {
+		t.Errorf("Different index:\nA:%v\nB:%v", []string{"id"}, c.Index())
+	}
+	if d := c.Loc("c"); d.Err != nil || d.Nrow() != 1 {
+		t.Errorf("Expected to find label c")
+	}
+	if c := a.SetIndex("id").RBind(b); c.Index() != nil {
+		t.Errorf("Expected DataFrame without index")
+	}
+	if c := a.SetIndex("id").RBind(b.SetIndex("n")); c.Err == nil {
+		t.Errorf("Expected error on different index columns")
+	}
+	if d := c.RBind(a.SetIndex("id")); d.Err == nil {
+		t.Errorf("Expected error on repeated labels")
+	}
+
+	// Labels repeated within a DataFrame don't conflict.
+	dup := New(
+		series.New([]string{"d", "d"}, series.String, "id"),
+		series.New([]int{4, 5}, series.Int, "n"),
+	).SetIndex("id")
+	d := c.RBind(dup)
+	if d.Err != nil || d.Nrow() != 5 {
+		t.Fatalf("Expected 5 rows, got %d: %v", d.Nrow(), d.Err)
+	}
+	if e := d.Loc("d"); e.Nrow() != 2 {
+		t.Errorf("Expected 2 rows with label d, got %d", e.Nrow())
+	}
+}
+
+func TestDataFrame_Index_Columns(t *testing.T) {
+	a := New(
+		series.New([]int{1, 2, 3}, series.Int, "A"),
+		series.New([]string{"a", "b", "c"}, series.String, "B"),
+		series.New([]float64{1.5, 2.5, 3.5}, series.Float, "C"),
+	).SetIndex("A")
+	other := New(
+		series.New([]int{3, 1}, series.Int, "X"),
+		series.New([]bool{true, false}, series.Bool, "D"),
+	).SetIndex("X")
+	table := []struct {
+		df    DataFrame
+		index []string
+	}{
+		{a.Rename("X", "A"), []string{"X"}},
+		{a.Rename("Y", "B"), []string{"A"}},
+		{a.Select([]string{"C", "A"}), []string{"A"}},
+		{a.Select([]string{"B", "C"}), nil},
+		{a.Drop("B"), []string{"A"}},
+		{a.Drop("A"), nil},
+		{a.Mutate(series.New([]int{4, 5, 6}, series.Int, "A")), []string{"A"}},
+		{a.Mutate(series.New([]int{4, 5, 6}, series.Int, "D")), []string{"A"}},
+	}
+	for i, tc := range table {
+		if tc.df.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, tc.df.Err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.index, tc.df.Index()) {
+			t.Errorf("Test: %d\nDifferent index:\nA:%v\nB:%v", i, tc.index, tc.df.Index())
+		}
+	}
+	if a.Index()[0] != "A" {
+		t.Errorf("Rename changed the index of the original DataFrame")
+	}
+
+	b := a.Rename("X", "A")
+	if c := b.Loc(1); c.Err != nil || !reflect.DeepEqual([][]string{{"X", "B", "C"}, {"1", "a", "1.500000"}}, c.Records()) {
+		t.Errorf("Unexpected Loc result: %v %v", c.Records(), c.Err)
+	}
+	c := b.CBind(other)
+	if c.Err != nil {
+		t.Fatalf("Error: %v", c.Err)
+	}
+	expected := [][]string{
+		{"X", "B", "C", "D"},
+		{"1", "a", "1.500000", "false"},
+		{"2", "b", "2.500000", "NaN"},
+		{"3", "c", "3.500000", "true"},
+	}
+	if !reflect.DeepEqual(expected, c.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, c.Records())
+	}
+}
@@ -0,0 +1,310 @@
+package dataframe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gota/gota/series"
+)
+
+// JoinType is the kind of join performed by DataFrame.Join.
+type JoinType int
+
+const (
+	// JoinInner keeps the pairs of rows of both DataFrames with matching keys.
+	JoinInner JoinType = iota
+	// JoinLeft keeps all the rows of the left DataFrame, with missing values
+	// for the columns of the right one when there is no match.
+	JoinLeft
+	// JoinRight keeps all the rows of the right DataFrame, with missing values
+	// for the columns of the left one when there is no match.
+	JoinRight
+	// JoinOuter keeps all the rows of both DataFrames.
+	JoinOuter
+	// JoinSemi keeps the rows of the left DataFrame with a match on the right
+	// one, with the columns of the left DataFrame only.
+	JoinSemi
+	// JoinAnti keeps the rows of the left DataFrame without a match on the
+	// right one, with the columns of the left DataFrame only.
+	JoinAnti
+)
+
+// JoinOption is the type used to configure joins.
+type JoinOption func(*joinOptions)
+
+type joinOptions struct {
+	// Suffixes added to the non-key columns present in both DataFrames. If
+	// not set, the columns are renamed like in New.
+	suffixes []string
+	// Maximum distance between the on values of AsofJoin matches.
+	tolerance    float64
+	hasTolerance bool
+}
+
+// Suffixes sets the suffixes added to the names of the non-key columns present
+// in both DataFrames, for the left and right DataFrame respectively. By
+// default, they are renamed to "name_0" and "name_1".
+func Suffixes(left, right string) JoinOption {
+	return func(c *joinOptions) {
+		c.suffixes = []string{left, right}
+	}
+}
+
+// Join returns the join of two DataFrames on the given key columns, which must
+// be present in both of them. It matches the rows using a hash table on the
+// key values of one of the DataFrames, with the keys of the right DataFrame
+// converted to the types of the left one. Rows with missing or NaN keys never
+// match.
+//
+// Except for semi and anti joins, the result has the key columns first,
+// followed by the rest of the columns of the left DataFrame and the rest of the
+// columns of the right DataFrame. The rows follow the order of the left
+// DataFrame, with the rows of the right DataFrame without match at the end.
+// Right joins follow the order of the right DataFrame instead.
+func (df DataFrame) Join(b DataFrame, how JoinType, keys []string, options ...JoinOption) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if b.Err != nil {
+		return b
+	}
+	cfg := joinOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+	iKeysA, iKeysB, err := joinKeys(df, b, keys)
+	if err != nil {
+		return DataFrame{Err: err}
+	}
+	keyTypes := make([]series.Type, len(iKeysA))
+	for k, i := range iKeysA {
+		keyTypes[k] = df.columns[i].Type()
+	}
+	tuplesA := df.keyTuples(iKeysA, keyTypes)
+	tuplesB := b.keyTuples(iKeysB, keyTypes)
+
+	var aIdx, bIdx []int
+	switch how {
+	case JoinInner, JoinLeft, JoinOuter, JoinSemi, JoinAnti:
+		table := hashKeys(tuplesB)
+		matchedB := make([]bool, b.nrows)
+		var rows []int
+		for i, tuple := range tuplesA {
+			var matches []int
+			if tuple != "" {
+				matches = table[tuple]
+			}
+			switch how {
+			case JoinSemi:
+				if len(matches) > 0 {
+					rows = append(rows, i)
+				}
+				continue
+			case JoinAnti:
+				if len(matches) == 0 {
+					rows = append(rows, i)
+				}
+				continue
+			}
+			for _, j := range matches {
+				aIdx = append(aIdx, i)
+				bIdx = append(bIdx, j)
+				matchedB[j] = true
+			}
+			if len(matches) == 0 && how != JoinInner {
+				aIdx = append(aIdx, i)
+				bIdx = append(bIdx, -1)
+			}
+		}
+		if how == JoinSemi || how == JoinAnti {
+			if rows == nil {
+				rows = []int{}
+			}
+			return df.Subset(rows)
+		}
+		if how == JoinOuter {
+			for j, matched := range matchedB {
+				if !matched {
+					aIdx = append(aIdx, -1)
+					bIdx = append(bIdx, j)
+				}
+			}
+		}
+	case JoinRight:
+		table := hashKeys(tuplesA)
+		var unmatched []int
+		for j, tuple := range tuplesB {
+			var matches []int
+			if tuple != "" {
+				matches = table[tuple]
+			}
+			for _, i := range matches {
+				aIdx = append(aIdx, i)
+				bIdx = append(bIdx, j)
+			}
+			if len(matches) == 0 {
+				unmatched = append(unmatched, j)
+			}
+		}
+		for _, j := range unmatched {
+			aIdx = append(aIdx, -1)
+			bIdx = append(bIdx, j)
+		}
+	default:
+		return DataFrame{Err: fmt.Errorf("join: unknown join type %d", how)}
+	}
+
+	// Build the columns of the result
+	var newCols []series.Series
+	for k, i := range iKeysA {
+		newCols = append(newCols, takeRows(df.columns[i], aIdx, b.columns[iKeysB[k]], bIdx))
+	}
+	var notKeysA, notKeysB []series.Series
+	for i, col := range df.columns {
+		if !inIntSlice(i, iKeysA) {
+			notKeysA = append(notKeysA, takeRows(col, aIdx, series.Series{}, nil))
+		}
+	}
+	for j, col := range b.columns {
+		if !inIntSlice(j, iKeysB) {
+			notKeysB = append(notKeysB, takeRows(col, bIdx, series.Series{}, nil))
+		}
+	}
+	cfg.addSuffixes(notKeysA, notKeysB)
+	newCols = append(newCols, notKeysA...)
+	newCols = append(newCols, notKeysB...)
+	return New(newCols...)
+}
+
+// addSuffixes renames the columns with the same name on both sides using the
+// configured suffixes, if any.
+func (cfg joinOptions) addSuffixes(left, right []series.Series) {
+	if cfg.suffixes == nil {
+		return
+	}
+	for i := range left {
+		for j := range right {
+			if left[i].Name == right[j].Name {
+				left[i].Name += cfg.suffixes[0]
+				right[j].Name += cfg.suffixes[1]
+				break
+			}
+		}
+	}
+}
+
+// SemiJoin returns the rows of the DataFrame with matching keys in b. See Join.
+func (df DataFrame) SemiJoin(b DataFrame, keys ...string) DataFrame {
+	return df.Join(b, JoinSemi, keys)
+}
+
+// AntiJoin returns the rows of the DataFrame without matching keys in b. See
+// Join.
+func (df DataFrame) AntiJoin(b DataFrame, keys ...string) DataFrame {
+	return df.Join(b, JoinAnti, keys)
+}
+
+// joinKeys returns the indexes of the key columns on both DataFrames.
+func joinKeys(a, b DataFrame, keys []string) ([]int, []int, error) {
+	if len(keys) == 0 {
+		return nil, nil, fmt.Errorf("join keys not specified")
+	}
+	// Check that we have all given keys in both DataFrames
+	var iKeysA []int
+	var iKeysB []int
+	var errorArr []string
+	for _, key := range keys {
+		i := a.colIndex(key)
+		if i < 0 {
+			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on left DataFrame", key))
+		}
+		iKeysA = append(iKeysA, i)
+		j := b.colIndex(key)
+		if j < 0 {
+			errorArr = append(errorArr, fmt.Sprintf("can't find key %q on right DataFrame", key))
+		}
+		iKeysB = append(iKeysB, j)
+	}
+	if len(errorArr) != 0 {
+		return nil, nil, fmt.Errorf("%s", strings.Join(errorArr, "\n"))
+	}
+	return iKeysA, iKeysB, nil
+}
+
+// keyTuples encodes the values of the given columns on every row, converted to
+// the given types. Rows with missing or NaN values get an empty string. String
+// and Categorical columns are encoded alike, so they are not converted.
+func (df DataFrame) keyTuples(colidx []int, types []series.Type) []string {
+	columns := make([]series.Series, len(colidx))
+	encoders := make([]func(int) string, len(colidx))
+	for k, i := range colidx {
+		col := df.columns[i]
+		if col.Type() != types[k] && !(isString(col.Type()) && isString(types[k])) {
+			col = series.New(col, types[k], col.Name)
+		}
+		columns[k] = col
+		encoders[k] = tupleEncoder(col)
+	}
+	tuples := make([]string, df.nrows)
+	var b strings.Builder
+rows:
+	for i := range tuples {
+		b.Reset()
+		for k, col := range columns {
+			if col.Elem(i).IsNA() {
+				continue rows
+			}
+			b.WriteString(encoders[k](i))
+		}
+		tuples[i] = b.String()
+	}
+	return tuples
+}
+
+// isString returns whether t holds strings, as String or Categorical.
+func isString(t series.Type) bool {
+	return t == series.String || t == series.Categorical
+}
+
+// hashKeys maps every encoded key to the rows where it appears.
+func hashKeys(tuples []string) map[string][]int {
+	table := make(map[string][]int, len(tuples))
+	for i, tuple := range tuples {
+		if tuple != "" {
+			table[tuple] = append(table[tuple], i)
+		}
+	}
+	return table
+}
+
+// takeRows returns the elements of s on the given rows, with the type of s. A
+// row of -1 takes the element of the same position of fallback if given, or a
+// missing value otherwise.
+func takeRows(s series.Series, rows []int, fallback series.Series, fallbackRows []int) series.Series {
+	complete := true
+	for _, i := range rows {
+		if i == -1 {
+			complete = false
+			break
+		}
+	}
+	if len(rows) == 0 {
+		return s.Empty()
+	}
+	if complete {
+		return s.Subset(rows)
+	}
+	values := make([]interface{}, len(rows))
+	for k, i := range rows {
+		switch {
+		case i != -1:
+			values[k] = s.Elem(i)
+		case fallbackRows != nil && fallbackRows[k] != -1:
+			values[k] = fallback.Elem(fallbackRows[k])
+		}
+	}
+	// Appended to an empty Series to keep the categories of Categorical ones
+	ret := s.Empty()
+	ret.Append(values)
+	return ret
+}
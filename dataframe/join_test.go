@@ -0,0 +1,249 @@
+package dataframe
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_Join(t *testing.T) {
+	a := New(
+		series.New([]interface{}{1, 2, 3, nil, 1}, series.Int, "id"),
+		series.New([]string{"a", "b", "c", "d", "e"}, series.String, "name"),
+		series.New([]float64{1.5, 2.5, 3.5, 4.5, 5.5}, series.Float, "value"),
+	)
+	b := New(
+		series.New([]interface{}{2.0, 1.0, 4.0, nil}, series.Float, "id"),
+		series.New([]float64{10, 20, 30, 40}, series.Float, "value"),
+	)
+	table := []struct {
+		how     JoinType
+		options []JoinOption
+		expDf   DataFrame
+	}{
+		{
+			JoinInner,
+			nil,
+			New(
+				series.New([]int{1, 2, 1}, series.Int, "id"),
+				series.New([]string{"a", "b", "e"}, series.String, "name"),
+				series.New([]float64{1.5, 2.5, 5.5}, series.Float, "value_0"),
+				series.New([]float64{20, 10, 20}, series.Float, "value_1"),
+			),
+		},
+		{
+			JoinLeft,
+			[]JoinOption{Suffixes("_a", "_b")},
+			New(
+				series.New([]interface{}{1, 2, 3, nil, 1}, series.Int, "id"),
+				series.New([]string{"a", "b", "c", "d", "e"}, series.String, "name"),
+				series.New([]float64{1.5, 2.5, 3.5, 4.5, 5.5}, series.Float, "value_a"),
+				series.New([]interface{}{20, 10, nil, nil, 20}, series.Float, "value_b"),
+			),
+		},
+		{
+			JoinRight,
+			[]JoinOption{Suffixes("", "_right")},
+			New(
+				series.New([]interface{}{2, 1, 1, 4, nil}, series.Int, "id"),
+				series.New([]interface{}{"b", "a", "e", nil, nil}, series.String, "name"),
+				series.New([]interface{}{2.5, 1.5, 5.5, nil, nil}, series.Float, "value"),
+				series.New([]float64{10, 20, 20, 30, 40}, series.Float, "value_right"),
+			),
+		},
+		{
+			JoinOuter,
+			[]JoinOption{Suffixes("_a", "_b")},
+			New(
+				series.New([]interface{}{1, 2, 3, nil, 1, 4, nil}, series.Int, "id"),
+				series.New([]interface{}{"a", "b", "c", "d", "e", nil, nil}, series.String, "name"),
+				series.New([]interface{}{1.5, 2.5, 3.5, 4.5, 5.5, nil, nil}, series.Float, "value_a"),
+				series.New([]interface{}{20, 10, nil, nil, 20, 30, 40}, series.Float, "value_b"),
+			),
+		},
+	}
+	for i, tc := range table {
+		c := a.Join(b, tc.how, []string{"id"}, tc.options...)
+
+		if err := c.Err; err != nil {
+			t.Fatalf("Test: %d\nError:%v", i, err)
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), c.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), c.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Names(), c.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, tc.expDf.Names(), c.Names())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), c.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), c.Records())
+		}
+		for j, col := range c.columns {
+			if exp := tc.expDf.columns[j].IsNull(); !reflect.DeepEqual(exp, col.IsNull()) {
+				t.Errorf("Test: %d\nDifferent nulls on %s:\nA:%v\nB:%v", i, col.Name, exp, col.IsNull())
+			}
+		}
+	}
+}
+
+func TestDataFrame_Join_MultipleKeys(t *testing.T) {
+	a := LoadRecords(
+		[][]string{
+			{"k1", "k2", "x"},
+			{"a", "1", "10"},
+			{"a", "2", "20"},
+			{"b", "1", "30"},
+		},
+	)
+	b := LoadRecords(
+		[][]string{
+			{"k2", "k1", "y"},
+			{"1", "b", "true"},
+			{"1", "a", "false"},
+			{"3", "a", "true"},
+		},
+	)
+	expected := LoadRecords(
+		[][]string{
+			{"k1", "k2", "x", "y"},
+			{"a", "1", "10", "false"},
+			{"b", "1", "30", "true"},
+		},
+	)
+	c := a.Join(b, JoinInner, []string{"k1", "k2"})
+	if c.Err != nil {
+		t.Fatalf("Error: %v", c.Err)
+	}
+	if !reflect.DeepEqual(expected.Records(), c.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected.Records(), c.Records())
+	}
+}
+
+func TestDataFrame_SemiJoin(t *testing.T) {
+	a := LoadRecords(
+		[][]string{
+			{"id", "name"},
+			{"1", "a"},
+			{"2", "b"},
+			{"3", "c"},
+			{"1", "d"},
+		},
+	)
+	b := LoadRecords(
+		[][]string{
+			{"id", "value"},
+			{"1", "x"},
+			{"1", "y"},
+			{"4", "z"},
+		},
+	)
+	table := []struct {
+		df    DataFrame
+		expDf DataFrame
+	}{
+		{
+			a.SemiJoin(b, "id"),
+			LoadRecords(
+				[][]string{
+					{"id", "name"},
+					{"1", "a"},
+					{"1", "d"},
+				},
+			),
+		},
+		{
+			a.AntiJoin(b, "id"),
+			LoadRecords(
+				[][]string{
+					{"id", "name"},
+					{"2", "b"},
+					{"3", "c"},
+				},
+			),
+		},
+		{
+			a.AntiJoin(a, "id"),
+			New(
+				series.New([]int{}, series.Int, "id"),
+				series.New([]string{}, series.String, "name"),
+			),
+		},
+	}
+	for i, tc := range table {
+		if tc.df.Err != nil {
+			t.Fatalf("Test: %d\nError:%v", i, tc.df.Err)
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), tc.df.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), tc.df.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), tc.df.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), tc.df.Records())
+		}
+	}
+}
+
+func TestDataFrame_Join_Errors(t *testing.T) {
+	a := LoadRecords([][]string{{"A", "B"}, {"1", "2"}})
+	b := LoadRecords([][]string{{"A", "C"}, {"1", "3"}})
+	table := []DataFrame{
+		a.Join(b, JoinInner, nil),
+		a.Join(b, JoinInner, []string{"B"}),
+		a.Join(b, JoinType(-1), []string{"A"}),
+		a.SemiJoin(b, "C"),
+		a.Join(DataFrame{Err: fmt.Errorf("error")}, JoinLeft, []string{"A"}),
+	}
+	for i, df := range table {
+		if df.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
+
+func TestDataFrame_Join_Categorical(t *testing.T) {
+	a := New(
+		series.NewCategorical([]string{"mid", "low", "high", "NaN"}, []string{"low", "mid", "high"}, "level"),
+		series.New([]int{1, 2, 3, 4}, series.Int, "x"),
+	)
+	b := New(
+		series.New([]string{"high", "mid", "top"}, series.Categorical, "level"),
+		series.New([]int{10, 20, 30}, series.Int, "y"),
+	)
+	c := a.Join(b, JoinOuter, []string{"level"})
+	if c.Err != nil {
+		t.Fatalf("Error: %v", c.Err)
+	}
+	expected := [][]string{
+		{"level", "x", "y"},
+		{"mid", "1", "20"},
+		{"low", "2", "NaN"},
+		{"high", "3", "10"},
+		{"NaN", "4", "NaN"},
+		{"NaN", "NaN", "30"},
+	}
+	if !reflect.DeepEqual(expected, c.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, c.Records())
+	}
+	level := c.Col("level")
+	if exp := []string{"low", "mid", "high"}; !reflect.DeepEqual(exp, level.Categories()) {
+		t.Errorf("Different categories:\nA:%v\nB:%v", exp, level.Categories())
+	}
+
+	// String keys match Categorical ones by value
+	d := New(
+		series.New([]string{"low", "high"}, series.String, "level"),
+		series.New([]bool{true, false}, series.Bool, "z"),
+	)
+	e := a.Join(d, JoinInner, []string{"level"})
+	expected = [][]string{
+		{"level", "x", "z"},
+		{"low", "2", "true"},
+		{"high", "3", "false"},
+	}
+	if !reflect.DeepEqual(expected, e.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expected, e.Records())
+	}
+	if e.Col("level").Type() != series.Categorical {
+		t.Errorf("Expected type %v, got %v", series.Categorical, e.Col("level").Type())
+	}
+}
@@ -0,0 +1,602 @@
+package dataframe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// JSONOrient sets the layout of the JSON written by WriteJSON.
+type JSONOrient int
+
+const (
+	// JSONRecords writes an array with an object per row:
+	// [{"A":1,"B":"x"},{"A":2,"B":"y"}]
+	JSONRecords JSONOrient = iota
+	// JSONColumns writes an object with an array per column:
+	// {"A":[1,2],"B":["x","y"]}
+	JSONColumns
+)
+
+// Orientation sets the orient option for writeOptions. The default is
+// JSONRecords.
+func Orientation(o JSONOrient) WriteOption {
+	return func(c *writeOptions) {
+		c.orient = o
+	}
+}
+
+// ReadNDJSON reads newline-delimited JSON, an object per line, from a
+// io.Reader and builds a DataFrame with the resulting records. The columns are
+// sorted by the first appearance of their keys. See NDJSONChunkReader to read
+// large inputs in bounded memory.
+func ReadNDJSON(r io.Reader, options ...LoadOption) DataFrame {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	keys, rows, err := readJSONObjects(d, -1)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("read ndjson: %v", err)}
+	}
+	if len(rows) == 0 {
+		return DataFrame{Err: fmt.Errorf("read ndjson: empty input")}
+	}
+	return loadJSONRows(keys, rows, options...)
+}
+
+// readJSONRecords reads a JSON array of objects, after its opening bracket.
+func readJSONRecords(d *json.Decoder, options ...LoadOption) DataFrame {
+	keys, rows, err := readJSONObjects(d, -1)
+	if err != nil {
+		return DataFrame{Err: err}
+	}
+	if _, err := d.Token(); err != nil {
+		return DataFrame{Err: err}
+	}
+	if len(rows) == 0 {
+		return DataFrame{Err: fmt.Errorf("load maps: empty array")}
+	}
+	return loadJSONRows(keys, rows, options...)
+}
+
+// readJSONColumns reads a JSON object with an array per column, after its
+// opening brace.
+func readJSONColumns(d *json.Decoder, options ...LoadOption) DataFrame {
+	var keys []string
+	var columns [][]interface{}
+	for d.More() {
+		key, err := d.Token()
+		if err != nil {
+			return DataFrame{Err: err}
+		}
+		v, err := readJSONValue(d)
+		if err != nil {
+			return DataFrame{Err: fmt.Errorf("column %v: %v", key, err)}
+		}
+		values, ok := v.([]interface{})
+		if !ok {
+			return DataFrame{Err: fmt.Errorf("column %v: expected array, got %v", key, jsonRecord(v))}
+		}
+		if len(columns) > 0 && len(values) != len(columns[0]) {
+			return DataFrame{Err: fmt.Errorf("column %v: expected %d values, got %d", key, len(columns[0]), len(values))}
+		}
+		keys = append(keys, key.(string))
+		columns = append(columns, values)
+	}
+	if _, err := d.Token(); err != nil {
+		return DataFrame{Err: err}
+	}
+	if len(columns) == 0 {
+		return DataFrame{Err: fmt.Errorf("empty DataFrame")}
+	}
+	rows := make([]map[string]interface{}, len(columns[0]))
+	for i := range rows {
+		row := make(map[string]interface{}, len(keys))
+		for j, key := range keys {
+			row[key] = columns[j][i]
+		}
+		rows[i] = row
+	}
+	return loadJSONRows(keys, rows, options...)
+}
+
+// readJSONObjects reads up to n JSON objects, or all of them if n is negative,
+// until the end of the input or of the enclosing array. It returns the keys in
+// order of first appearance along with the objects.
+func readJSONObjects(d *json.Decoder, n int) ([]string, []map[string]interface{}, error) {
+	var keys []string
+	seen := make(map[string]bool)
+	var rows []map[string]interface{}
+	for n < 0 || len(rows) < n {
+		if !d.More() {
+			break
+		}
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if tok != json.Delim('{') {
+			return nil, nil, fmt.Errorf("expected object, got %v", tok)
+		}
+		row := make(map[string]interface{})
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			v, err := readJSONValue(d)
+			if err != nil {
+				return nil, nil, err
+			}
+			k := key.(string)
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+			row[k] = v
+		}
+		if _, err := d.Token(); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return keys, rows, nil
+}
+
+// jsonObject is a JSON object that keeps the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// readJSONValue reads the next JSON value. Objects are returned as jsonObject
+// and numbers as json.Number.
+func readJSONValue(d *json.Decoder) (interface{}, error) {
+	tok, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		o := jsonObject{values: make(map[string]interface{})}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := readJSONValue(d)
+			if err != nil {
+				return nil, err
+			}
+			k := key.(string)
+			if _, ok := o.values[k]; !ok {
+				o.keys = append(o.keys, k)
+			}
+			o.values[k] = v
+		}
+		_, err := d.Token()
+		return o, err
+	case json.Delim('['):
+		values := []interface{}{}
+		for d.More() {
+			v, err := readJSONValue(d)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		_, err := d.Token()
+		return values, err
+	}
+	return tok, nil
+}
+
+// loadJSONRows builds a DataFrame with the values of the given keys, applying
+// the FlattenNested and ExplodeArrays options.
+func loadJSONRows(keys []string, rows []map[string]interface{}, options ...LoadOption) DataFrame {
+	cfg := loadOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+	keys, rows, err := flattenJSON(keys, rows, cfg)
+	if err != nil {
+		return DataFrame{Err: err}
+	}
+	return LoadRecords(append([][]string{keys}, jsonRecords(keys, rows)...), options...)
+}
+
+// jsonField is a value of a flattened row.
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// flattenJSON expands the nested objects and arrays of the rows as set by the
+// FlattenNested and ExplodeArrays options. It returns the new keys in order of
+// first appearance along with the new rows. Flattened keys that collide with
+// other keys of the same row are an error.
+func flattenJSON(keys []string, rows []map[string]interface{}, cfg loadOptions) ([]string, []map[string]interface{}, error) {
+	if !cfg.flatten && !cfg.explodeArrays {
+		return keys, rows, nil
+	}
+	var newKeys []string
+	seen := make(map[string]bool)
+	var newRows []map[string]interface{}
+	for i, row := range rows {
+		o := jsonObject{keys: keys, values: row}
+		flattened, err := flattenObject(o, "", 0, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("row %d: %v", i, err)
+		}
+		for _, fields := range flattened {
+			m := make(map[string]interface{}, len(fields))
+			for _, f := range fields {
+				if _, ok := m[f.key]; ok {
+					return nil, nil, fmt.Errorf("row %d: flattened key %q is duplicated", i, f.key)
+				}
+				if !seen[f.key] {
+					seen[f.key] = true
+					newKeys = append(newKeys, f.key)
+				}
+				m[f.key] = f.value
+			}
+			newRows = append(newRows, m)
+		}
+	}
+	return newKeys, newRows, nil
+}
+
+// flattenObject returns the rows resulting from flattening the fields of an
+// object at the given depth. Arrays of different fields are exploded together,
+// element by element, so they must have the same length. Fields with a single
+// value are repeated on every row.
+func flattenObject(o jsonObject, prefix string, depth int, cfg loadOptions) ([][]jsonField, error) {
+	rows := [][]jsonField{nil}
+	exploded := ""
+	for _, k := range o.keys {
+		v, ok := o.values[k]
+		if !ok {
+			continue
+		}
+		key := k
+		if prefix != "" {
+			key = prefix + cfg.flattenSep + k
+		}
+		sub, err := flattenValue(v, key, depth, cfg)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(sub) == 1:
+			for i := range rows {
+				rows[i] = append(rows[i][:len(rows[i]):len(rows[i])], sub[0]...)
+			}
+			continue
+		case len(rows) == 1:
+			for i := range sub {
+				sub[i] = append(rows[0][:len(rows[0]):len(rows[0])], sub[i]...)
+			}
+			rows = sub
+		case len(rows) == len(sub):
+			for i := range rows {
+				rows[i] = append(rows[i], sub[i]...)
+			}
+		default:
+			return nil, fmt.Errorf("can't explode %q and %q with %d and %d values", exploded, key, len(rows), len(sub))
+		}
+		exploded = key
+	}
+	return rows, nil
+}
+
+// flattenValue returns the rows resulting from flattening a value with the
+// given key.
+func flattenValue(v interface{}, key string, depth int, cfg loadOptions) ([][]jsonField, error) {
+	switch x := v.(type) {
+	case jsonObject, map[string]interface{}:
+		if cfg.flatten && (cfg.flattenDepth <= 0 || depth < cfg.flattenDepth) {
+			return flattenObject(toJSONObject(x), key, depth+1, cfg)
+		}
+	case []interface{}:
+		if cfg.explodeArrays {
+			if len(x) == 0 {
+				return [][]jsonField{{{key, nil}}}, nil
+			}
+			var rows [][]jsonField
+			for _, e := range x {
+				sub, err := flattenValue(e, key, depth, cfg)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, sub...)
+			}
+			return rows, nil
+		}
+	}
+	return [][]jsonField{{{key, v}}}, nil
+}
+
+// toJSONObject returns v as a jsonObject, sorting the keys of plain maps.
+func toJSONObject(v interface{}) jsonObject {
+	if o, ok := v.(jsonObject); ok {
+		return o
+	}
+	m := v.(map[string]interface{})
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return jsonObject{keys: keys, values: m}
+}
+
+// jsonRecords converts the values of the given keys to records, without
+// header.
+func jsonRecords(keys []string, rows []map[string]interface{}) [][]string {
+	records := make([][]string, len(rows))
+	for i, m := range rows {
+		record := make([]string, len(keys))
+		for j, key := range keys {
+			record[j] = jsonRecord(m[key])
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// jsonRecord formats a value decoded from JSON, or given to LoadMaps, as a
+// record. Missing values are "NaN", which is always loaded as missing.
+func jsonRecord(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NaN"
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case jsonObject, map[string]interface{}, []interface{}:
+		// Nested values are kept as JSON
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// NDJSONChunkReader reads newline-delimited JSON as a sequence of DataFrames
+// of at most a fixed number of rows, so that inputs larger than memory can be
+// processed one chunk at a time. With ExplodeArrays, the limit is on the
+// number of objects read, and the chunks can have more rows than that once
+// their arrays are exploded.
+//
+// All the chunks share the same column names and types. The columns are the
+// keys found in the first chunk, and keys that only appear later are ignored.
+// The types are taken from the WithTypes option when given, and are otherwise
+// detected from the first chunk, as with CSVChunkReader.
+type NDJSONChunkReader struct {
+	d         *json.Decoder
+	chunkRows int
+	cfg       loadOptions
+	keys      []string
+	headers   []string
+	schema    []series.Type
+	err       error
+}
+
+// NewNDJSONChunkReader creates a NDJSONChunkReader that reads chunks of
+// chunkRows rows from r. It accepts the same options as ReadNDJSON, except
+// HasHeader.
+func NewNDJSONChunkReader(r io.Reader, chunkRows int, options ...LoadOption) *NDJSONChunkReader {
+	cfg := defaultLoadOptions()
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	cr := &NDJSONChunkReader{
+		d:         d,
+		chunkRows: chunkRows,
+		cfg:       cfg,
+	}
+	if chunkRows <= 0 {
+		cr.err = fmt.Errorf("ndjson chunk reader: chunk size must be positive: %d", chunkRows)
+	}
+	return cr
+}
+
+// Next reads the next chunk of the input. Once all the rows have been read, it
+// returns io.EOF. Any other error is returned both as the error and in the
+// Err field of the DataFrame, and every later call returns it again.
+func (cr *NDJSONChunkReader) Next() (DataFrame, error) {
+	if cr.err != nil {
+		return DataFrame{Err: cr.err}, cr.err
+	}
+	keys, rows, err := readJSONObjects(cr.d, cr.chunkRows)
+	if err != nil {
+		return cr.fail(err)
+	}
+	keys, rows, err = flattenJSON(keys, rows, cr.cfg)
+	if err != nil {
+		return cr.fail(fmt.Errorf("ndjson chunk reader: %v", err))
+	}
+	if len(rows) == 0 {
+		if cr.keys == nil {
+			return cr.fail(fmt.Errorf("ndjson chunk reader: empty input"))
+		}
+		cr.err = io.EOF
+		return DataFrame{Err: io.EOF}, io.EOF
+	}
+	if cr.keys == nil {
+		headers := append([]string(nil), keys...)
+		if cr.cfg.names != nil {
+			if len(cr.cfg.names) != len(keys) {
+				if len(cr.cfg.names) > len(keys) {
+					return cr.fail(fmt.Errorf("ndjson chunk reader: too many column names"))
+				}
+				return cr.fail(fmt.Errorf("ndjson chunk reader: not enough column names"))
+			}
+			copy(headers, cr.cfg.names)
+		}
+		fixColnames(headers)
+		cr.keys, cr.headers = keys, headers
+	}
+
+	df, failures := loadRecords(cr.headers, jsonRecords(cr.keys, rows), cr.schema, cr.cfg)
+	if df.Err != nil {
+		return cr.fail(df.Err)
+	}
+	if cr.schema == nil {
+		cr.schema = df.Types()
+	}
+	if df = cr.cfg.conform(df, failures); df.Err != nil {
+		return cr.fail(df.Err)
+	}
+	return df, nil
+}
+
+// Names returns the column names of the chunks. It is empty until the first
+// call to Next.
+func (cr *NDJSONChunkReader) Names() []string {
+	if cr.headers == nil {
+		return nil
+	}
+	return append([]string(nil), cr.headers...)
+}
+
+func (cr *NDJSONChunkReader) fail(err error) (DataFrame, error) {
+	cr.err = err
+	return DataFrame{Err: err}, err
+}
+
+// WriteNDJSON writes the DataFrame to the given io.Writer as newline-delimited
+// JSON, an object per row with the keys in column order. Missing, NaN and
+// infinite elements are written as null.
+func (df DataFrame) WriteNDJSON(w io.Writer) error {
+	if df.Err != nil {
+		return df.Err
+	}
+	bw := bufio.NewWriter(w)
+	for i := 0; i < df.nrows; i++ {
+		if err := df.writeJSONRow(bw, i); err != nil {
+			return err
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// writeJSONRecords writes the rows as a JSON array of objects.
+func (df DataFrame) writeJSONRecords(w *bufio.Writer) error {
+	w.WriteByte('[')
+	for i := 0; i < df.nrows; i++ {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		if err := df.writeJSONRow(w, i); err != nil {
+			return err
+		}
+	}
+	w.WriteString("]\n")
+	return nil
+}
+
+// writeJSONColumns writes the columns as a JSON object of arrays.
+func (df DataFrame) writeJSONColumns(w *bufio.Writer) error {
+	w.WriteByte('{')
+	for j, col := range df.columns {
+		if j > 0 {
+			w.WriteByte(',')
+		}
+		if err := writeJSONValue(w, col.Name); err != nil {
+			return err
+		}
+		w.WriteString(":[")
+		for i := 0; i < df.nrows; i++ {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			if err := writeJSONValue(w, jsonValue(col.Elem(i))); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// writeJSONRow writes the i-th row as a JSON object.
+func (df DataFrame) writeJSONRow(w *bufio.Writer, i int) error {
+	w.WriteByte('{')
+	for j, col := range df.columns {
+		if j > 0 {
+			w.WriteByte(',')
+		}
+		if err := writeJSONValue(w, col.Name); err != nil {
+			return err
+		}
+		w.WriteByte(':')
+		if err := writeJSONValue(w, jsonValue(col.Elem(i))); err != nil {
+			return err
+		}
+	}
+	w.WriteByte('}')
+	return nil
+}
+
+func writeJSONValue(w *bufio.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// jsonValue returns the value of an element to be encoded as JSON, with nil for
+// the values that JSON can't represent.
+func jsonValue(e series.Element) interface{} {
+	v := e.Val()
+	switch f := v.(type) {
+	case float64:
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil
+		}
+	case float32:
+		if math.IsInf(float64(f), 0) || math.IsNaN(float64(f)) {
+			return nil
+		}
+	}
+	return v
+}
@@ -0,0 +1,272 @@
+package dataframe
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestReadJSON_Orientations(t *testing.T) {
+	expected := New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "B"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "A"),
+		series.New([]interface{}{1.5, nil, 3.0}, series.Float, "C"),
+	)
+	table := []struct {
+		jsonStr string
+		expDf   DataFrame
+	}{
+		{
+			`[{"B":"a","A":1,"C":1.5},{"A":2},{"B":"c","A":null,"C":3}]`,
+			expected,
+		},
+		{
+			`{"B":["a",null,"c"],"A":[1,2,null],"C":[1.5,null,3]}`,
+			expected,
+		},
+	}
+	for i, tc := range table {
+		b := ReadJSON(strings.NewReader(tc.jsonStr))
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	for i, jsonStr := range []string{
+		`{"A":[1,2],"B":[1]}`,
+		`{"A":1}`,
+		`[{"A":1},2]`,
+		`"A"`,
+		`[]`,
+		`{}`,
+	} {
+		if b := ReadJSON(strings.NewReader(jsonStr)); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error on %s", i, jsonStr)
+		}
+	}
+}
+
+func TestReadNDJSON(t *testing.T) {
+	ndjson := `{"B":"a","A":1}
+
+{"A":2,"C":true}
+{"B":"c","A":null,"C":false}
+`
+	b := ReadNDJSON(strings.NewReader(ndjson))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	expected := New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "B"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "A"),
+		series.New([]interface{}{nil, true, false}, series.Bool, "C"),
+	)
+	compareFrames(t, 0, expected, b)
+
+	for i, ndjson := range []string{"", `{"A":1}` + "\n" + `[1]`, `{"A":1`} {
+		if b := ReadNDJSON(strings.NewReader(ndjson)); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
+
+func TestNDJSONChunkReader(t *testing.T) {
+	ndjson := `{"A":"a","B":1}
+{"A":"b","B":2}
+{"A":"c","B":"x","C":true}
+`
+	cr := NewNDJSONChunkReader(strings.NewReader(ndjson), 2, Names("X", "Y"))
+	expDfs := []DataFrame{
+		New(
+			series.New([]string{"a", "b"}, series.String, "X"),
+			series.New([]int{1, 2}, series.Int, "Y"),
+		),
+		New(
+			series.New([]string{"c"}, series.String, "X"),
+			series.New([]interface{}{nil}, series.Int, "Y"),
+		),
+	}
+	for i, expDf := range expDfs {
+		df, err := cr.Next()
+		if err != nil {
+			t.Fatalf("Chunk: %d\nError: %v", i, err)
+		}
+		compareFrames(t, i, expDf, df)
+	}
+	if _, err := cr.Next(); err != io.EOF {
+		t.Errorf("Expected io.EOF, got %v", err)
+	}
+	if names := cr.Names(); len(names) != 2 || names[0] != "X" || names[1] != "Y" {
+		t.Errorf("Expected names [X Y], got %v", names)
+	}
+
+	for i, cr := range []*NDJSONChunkReader{
+		NewNDJSONChunkReader(strings.NewReader(ndjson), 0),
+		NewNDJSONChunkReader(strings.NewReader(""), 2),
+		NewNDJSONChunkReader(strings.NewReader(ndjson), 2, Names("X")),
+		NewNDJSONChunkReader(strings.NewReader(`{"A":1} 2`), 2),
+	} {
+		if _, err := cr.Next(); err == nil || err == io.EOF {
+			t.Errorf("Test: %d\nExpected error, got %v", i, err)
+		}
+	}
+}
+
+func TestDataFrame_WriteJSON_Orientations(t *testing.T) {
+	a := New(
+		series.New([]interface{}{"a", nil}, series.String, "B"),
+		series.New([]interface{}{1, nil}, series.Int, "A"),
+		series.New([]interface{}{math.NaN(), math.Inf(1)}, series.Float, "C"),
+		series.New([]interface{}{"2021-10-10T12:30:00Z", nil}, series.Time, "D"),
+	)
+	table := []struct {
+		options  []WriteOption
+		expected string
+	}{
+		{
+			nil,
+			`[{"B":"a","A":1,"C":null,"D":"2021-10-10T12:30:00Z"},{"B":null,"A":null,"C":null,"D":null}]
+`,
+		},
+		{
+			[]WriteOption{Orientation(JSONColumns)},
+			`{"B":["a",null],"A":[1,null],"C":[null,null],"D":["2021-10-10T12:30:00Z",null]}
+`,
+		},
+	}
+	for i, tc := range table {
+		buf := new(bytes.Buffer)
+		if err := a.WriteJSON(buf, tc.options...); err != nil {
+			t.Errorf("Test: %d\nError: %v", i, err)
+			continue
+		}
+		if buf.String() != tc.expected {
+			t.Errorf("Test: %d\nExpected:\n%s\nReceived:\n%s", i, tc.expected, buf.String())
+		}
+		if b := ReadJSON(bytes.NewReader(buf.Bytes())); b.Err != nil || b.Names()[0] != "B" {
+			t.Errorf("Test: %d\nExpected to read back the columns in order, got %v %v", i, b.Names(), b.Err)
+		}
+	}
+	if err := a.WriteJSON(new(bytes.Buffer), Orientation(JSONOrient(10))); err == nil {
+		t.Errorf("Expected error on unknown orientation")
+	}
+}
+
+func TestDataFrame_WriteNDJSON(t *testing.T) {
+	a := New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "B"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "A"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "C"),
+	)
+	buf := new(bytes.Buffer)
+	if err := a.WriteNDJSON(buf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	expected := `{"B":"a","A":1,"C":true}
+{"B":null,"A":2,"C":null}
+{"B":"c","A":null,"C":false}
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nReceived:\n%s", expected, buf.String())
+	}
+	b := ReadNDJSON(bytes.NewReader(buf.Bytes()))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	compareFrames(t, 0, a, b)
+}
+
+func TestReadJSON_FlattenNested(t *testing.T) {
+	jsonStr := `[
+{"id":1,"user":{"name":"a","address":{"city":"x","zip":"1"}},"tags":["p","q"]},
+{"id":2,"user":{"name":"b"},"tags":[]}
+]`
+	table := []struct {
+		options []LoadOption
+		expDf   DataFrame
+	}{
+		{
+			nil,
+			New(
+				series.New([]int{1, 2}, series.Int, "id"),
+				series.New([]string{`{"name":"a","address":{"city":"x","zip":"1"}}`, `{"name":"b"}`}, series.String, "user"),
+				series.New([]string{`["p","q"]`, `[]`}, series.String, "tags"),
+			),
+		},
+		{
+			[]LoadOption{FlattenNested(".", 0)},
+			New(
+				series.New([]int{1, 2}, series.Int, "id"),
+				series.New([]string{"a", "b"}, series.String, "user.name"),
+				series.New([]interface{}{"x", nil}, series.String, "user.address.city"),
+				series.New([]interface{}{1, nil}, series.Int, "user.address.zip"),
+				series.New([]string{`["p","q"]`, `[]`}, series.String, "tags"),
+			),
+		},
+		{
+			[]LoadOption{FlattenNested("_", 1), ExplodeArrays(true)},
+			New(
+				series.New([]int{1, 1, 2}, series.Int, "id"),
+				series.New([]string{"a", "a", "b"}, series.String, "user_name"),
+				series.New([]interface{}{`{"city":"x","zip":"1"}`, `{"city":"x","zip":"1"}`, nil}, series.String, "user_address"),
+				series.New([]interface{}{"p", "q", nil}, series.String, "tags"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := ReadJSON(strings.NewReader(jsonStr), tc.options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+}
+
+func TestExplodeArrays(t *testing.T) {
+	ndjson := `{"a":[1,2],"b":["x","y"],"items":[{"k":1},{"k":2,"v":true}]}`
+	b := ReadNDJSON(strings.NewReader(ndjson), FlattenNested(".", 0), ExplodeArrays(true))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	// Arrays are exploded together, element by element
+	compareFrames(t, 0, New(
+		series.New([]int{1, 2}, series.Int, "a"),
+		series.New([]string{"x", "y"}, series.String, "b"),
+		series.New([]int{1, 2}, series.Int, "items.k"),
+		series.New([]interface{}{nil, true}, series.Bool, "items.v"),
+	), b)
+
+	for i, ndjson := range []string{
+		`{"a":[1,2],"b":["x","y","z"]}`,
+		`{"a":[1,2],"b":{"c":[1,2,3]}}`,
+		`{"a.b":1,"a":{"b":2}}`,
+		`{"a":{"b":2},"a.b":1}`,
+	} {
+		b := ReadNDJSON(strings.NewReader(ndjson), FlattenNested(".", 0), ExplodeArrays(true))
+		if b.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+
+	maps := []map[string]interface{}{
+		{"id": 1, "meta": map[string]interface{}{"b": 2, "a": 1}, "list": []interface{}{"x", "y"}},
+	}
+	b = LoadMaps(maps, FlattenNested(".", 0), ExplodeArrays(true))
+	exp := New(
+		series.New([]int{1, 1}, series.Int, "id"),
+		series.New([]string{"x", "y"}, series.String, "list"),
+		series.New([]int{1, 1}, series.Int, "meta.a"),
+		series.New([]int{2, 2}, series.Int, "meta.b"),
+	)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	compareFrames(t, 0, exp, b)
+}
@@ -0,0 +1,129 @@
+package dataframe
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// ResampleOption is the type used to configure a Resample call.
+type ResampleOption func(*resampleOptions)
+
+type resampleOptions struct {
+	// If set, buckets without rows between the first and the last bucket are
+	// also generated.
+	fillEmpty bool
+}
+
+// maxResampleBuckets is the largest number of buckets that FillEmpty can
+// generate, so that a far outlier timestamp can't exhaust the memory.
+const maxResampleBuckets = 1000000
+
+// FillEmpty sets the fillEmpty option for resampleOptions. Resample fails if
+// filling the empty buckets would generate more than a million buckets.
+func FillEmpty(b bool) ResampleOption {
+	return func(c *resampleOptions) {
+		c.fillEmpty = b
+	}
+}
+
+// Resample groups the rows of a DataFrame into time buckets of the given
+// frequency, based on the values of a Time column. The frequency follows the
+// format of time.ParseDuration, with the addition of the "d" (day) and "w"
+// (week) units, e.g. "15m", "1h" or "7d".
+//
+// Every bucket is keyed on its start time, truncated from the zero time in UTC.
+// Rows with a missing timestamp are not assigned to any bucket. The returned
+// Groups are ordered by bucket, so that Aggregation emits one row per bucket
+// in chronological order, with the bucket start on the time column.
+func (df DataFrame) Resample(colname, freq string, options ...ResampleOption) *Groups {
+	if df.Err != nil {
+		return &Groups{Err: df.Err}
+	}
+	cfg := resampleOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	d, err := parseFrequency(freq)
+	if err != nil {
+		return &Groups{Err: fmt.Errorf("Resample: %v", err)}
+	}
+	idx := df.colIndex(colname)
+	if idx < 0 {
+		return &Groups{Err: fmt.Errorf("Resample: can't find column name: %s", colname)}
+	}
+	col := df.columns[idx]
+	if col.Type() != series.Time {
+		return &Groups{Err: fmt.Errorf("Resample: column %s is not of type %s", colname, series.Time)}
+	}
+
+	rows := make(map[int64][]int)
+	var starts []time.Time
+	for i := 0; i < df.nrows; i++ {
+		t, err := col.Elem(i).Time()
+		if err != nil {
+			continue
+		}
+		start := t.Truncate(d)
+		if _, ok := rows[start.UnixNano()]; !ok {
+			starts = append(starts, start)
+		}
+		rows[start.UnixNano()] = append(rows[start.UnixNano()], i)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	if cfg.fillEmpty && len(starts) > 1 {
+		first, last := starts[0], starts[len(starts)-1]
+		if n := int64(last.Sub(first)/d) + 1; n > maxResampleBuckets {
+			return &Groups{Err: fmt.Errorf("Resample: filling empty buckets would generate %d buckets, more than %d", n, maxResampleBuckets)}
+		}
+		starts = starts[:0]
+		for start := first; !start.After(last); start = start.Add(d) {
+			starts = append(starts, start)
+		}
+	}
+
+	groups := &Groups{
+		groups:   make(map[string]DataFrame, len(starts)),
+		colnames: []string{colname},
+		keys:     make([]groupKey, len(starts)),
+		df:       df,
+	}
+	for i, start := range starts {
+		id := start.Format(time.RFC3339Nano)
+		groups.groups[id] = df.Subset(rows[start.UnixNano()])
+		groups.keys[i] = groupKey{
+			id:     id,
+			values: []series.Element{series.Times(start).Elem(0)},
+			rows:   rows[start.UnixNano()],
+		}
+	}
+	return groups
+}
+
+// parseFrequency parses a resampling frequency into a positive duration.
+func parseFrequency(freq string) (time.Duration, error) {
+	var d time.Duration
+	var err error
+	switch {
+	case strings.HasSuffix(freq, "d"), strings.HasSuffix(freq, "w"):
+		unit := 24 * time.Hour
+		if strings.HasSuffix(freq, "w") {
+			unit *= 7
+		}
+		var n int
+		n, err = strconv.Atoi(freq[:len(freq)-1])
+		d = time.Duration(n) * unit
+	default:
+		d, err = time.ParseDuration(freq)
+	}
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid frequency %q", freq)
+	}
+	return d, nil
+}
@@ -0,0 +1,84 @@
+package dataframe
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_Resample(t *testing.T) {
+	a := New(
+		series.New([]string{
+			"2021-10-10T10:45:00Z",
+			"2021-10-10T08:15:00Z",
+			"2021-10-10T08:30:00Z",
+			"NaN",
+			"2021-10-10T10:00:00Z",
+		}, series.Time, "ts"),
+		series.New([]float64{4, 1, 2, 8, 3}, series.Float, "values"),
+	)
+	table := []struct {
+		groups  *Groups
+		typs    []AggregationType
+		columns []string
+		expDf   DataFrame
+	}{
+		{
+			a.Resample("ts", "1h"),
+			[]AggregationType{Aggregation_SUM, Aggregation_COUNT},
+			[]string{"values", "values"},
+			New(
+				series.New([]string{"2021-10-10T08:00:00Z", "2021-10-10T10:00:00Z"}, series.Time, "ts"),
+				series.New([]float64{3, 7}, series.Float, "values_SUM"),
+				series.New([]float64{2, 2}, series.Float, "values_COUNT"),
+			),
+		},
+		{
+			a.Resample("ts", "1h", FillEmpty(true)),
+			[]AggregationType{Aggregation_MAX, Aggregation_COUNT},
+			[]string{"values", "values"},
+			New(
+				series.New([]string{"2021-10-10T08:00:00Z", "2021-10-10T09:00:00Z", "2021-10-10T10:00:00Z"}, series.Time, "ts"),
+				series.New([]string{"2", "nan", "4"}, series.Float, "values_MAX"),
+				series.New([]float64{2, 0, 2}, series.Float, "values_COUNT"),
+			),
+		},
+		{
+			a.Resample("ts", "1d"),
+			[]AggregationType{Aggregation_MEAN},
+			[]string{"values"},
+			New(
+				series.New([]string{"2021-10-10"}, series.Time, "ts"),
+				series.New([]float64{2.5}, series.Float, "values_MEAN"),
+			),
+		},
+	}
+	for i, tc := range table {
+		if tc.groups.Err != nil {
+			t.Fatalf("Test: %d\nError: %v", i, tc.groups.Err)
+		}
+		b := tc.groups.Aggregation(tc.typs, tc.columns)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), b.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), b.Records())
+		}
+	}
+
+	for i, groups := range []*Groups{
+		a.Resample("values", "1h"),
+		a.Resample("ts", "1x"),
+		a.Resample("ts", "-1h"),
+		a.Resample("missing", "1h"),
+		a.Resample("ts", "1ns", FillEmpty(true)),
+	} {
+		if groups.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+	}
+}
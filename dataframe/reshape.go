@@ -0,0 +1,159 @@
+package dataframe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gota/gota/series"
+)
+
+// Pivot reshapes the DataFrame from long to wide form. It groups the rows by
+// the index columns and aggregates the values columns with agg, creating one
+// column for every distinct value of the columns columns.
+//
+// The result has the index columns first, keeping their types, with one row per
+// distinct index sorted by key. They are followed by the aggregated columns,
+// sorted by the key of the pivot values, of type Float. Their names are the
+// pivot values joined with "_", prefixed with the name of the values column if
+// there is more than one. Combinations of index and pivot values not present
+// in the DataFrame are missing.
+func (df DataFrame) Pivot(index, columns, values []string, agg AggregationType) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if len(index) == 0 || len(columns) == 0 || len(values) == 0 {
+		return DataFrame{Err: fmt.Errorf("pivot: index, columns and values can't be empty")}
+	}
+	for _, c := range append(append(append([]string{}, index...), columns...), values...) {
+		if findInStringSlice(c, df.Names()) == -1 {
+			return DataFrame{Err: fmt.Errorf("pivot: can't find column name: %s", c)}
+		}
+	}
+
+	rowGroups := df.GroupBy(index...).SortByKey()
+	colGroups := df.GroupBy(columns...).SortByKey()
+	typs := make([]AggregationType, len(values))
+	for i := range typs {
+		typs[i] = agg
+	}
+	groupby := append(append([]string{}, index...), columns...)
+	aggregated := df.GroupBy(groupby...).Aggregation(typs, values)
+	if aggregated.Err != nil {
+		return DataFrame{Err: fmt.Errorf("pivot: %v", aggregated.Err)}
+	}
+
+	rowIndex := make(map[string]int, len(rowGroups.keys))
+	for i, key := range rowGroups.keys {
+		rowIndex[groupTuple(key.values)] = i
+	}
+	colIndex := make(map[string]int, len(colGroups.keys))
+	for i, key := range colGroups.keys {
+		colIndex[groupTuple(key.values)] = i
+	}
+
+	// cells[k][j][i] holds the aggregated values column k for the pivot
+	// value j and the index i.
+	cells := make([][][]interface{}, len(values))
+	for k := range cells {
+		cells[k] = make([][]interface{}, len(colGroups.keys))
+		for j := range cells[k] {
+			cells[k][j] = make([]interface{}, len(rowGroups.keys))
+		}
+	}
+	rowValues := make([]series.Element, len(index))
+	colValues := make([]series.Element, len(columns))
+	for r := 0; r < aggregated.nrows; r++ {
+		for i := range index {
+			rowValues[i] = aggregated.columns[i].Elem(r)
+		}
+		for j := range columns {
+			colValues[j] = aggregated.columns[len(index)+j].Elem(r)
+		}
+		i, j := rowIndex[groupTuple(rowValues)], colIndex[groupTuple(colValues)]
+		for k := range values {
+			cells[k][j][i] = aggregated.columns[len(groupby)+k].Elem(r).Float()
+		}
+	}
+
+	result := rowGroups.keyColumns()
+	for k, v := range values {
+		for j, key := range colGroups.keys {
+			parts := make([]string, len(key.values))
+			for p, e := range key.values {
+				parts[p] = e.String()
+			}
+			name := strings.Join(parts, "_")
+			if len(values) > 1 {
+				name = v + "_" + name
+			}
+			result = append(result, series.New(cells[k][j], series.Float, name))
+		}
+	}
+	return New(result...)
+}
+
+// Melt reshapes the DataFrame from wide to long form. For every column in
+// valueVars, it adds one row per row of the DataFrame with the idVars columns,
+// the name of the column in the varName column and its value in the valueName
+// column. If valueVars is empty, all the columns not in idVars are used. If
+// varName or valueName are empty, "variable" and "value" are used.
+//
+// The id columns keep their types. The value column has the type of the value
+// columns if they all have the same type, Float if they are a mix of Int and
+// Float, and String otherwise.
+func (df DataFrame) Melt(idVars, valueVars []string, varName, valueName string) DataFrame {
+	if df.Err != nil {
+		return df
+	}
+	if varName == "" {
+		varName = "variable"
+	}
+	if valueName == "" {
+		valueName = "value"
+	}
+	for _, c := range append(append([]string{}, idVars...), valueVars...) {
+		if findInStringSlice(c, df.Names()) == -1 {
+			return DataFrame{Err: fmt.Errorf("melt: can't find column name: %s", c)}
+		}
+	}
+	if len(valueVars) == 0 {
+		for _, c := range df.Names() {
+			if findInStringSlice(c, idVars) == -1 {
+				valueVars = append(valueVars, c)
+			}
+		}
+	}
+	if len(valueVars) == 0 {
+		return DataFrame{Err: fmt.Errorf("melt: no value columns")}
+	}
+
+	t := df.Col(valueVars[0]).Type()
+	for _, c := range valueVars[1:] {
+		ct := df.Col(c).Type()
+		switch {
+		case ct == t:
+		case isNumeric(t) && isNumeric(ct):
+			t, _ = series.PromoteTypes(t, ct)
+		default:
+			t = series.String
+		}
+	}
+
+	rows := make([]int, 0, df.nrows*len(valueVars))
+	names := make([]string, 0, df.nrows*len(valueVars))
+	value := series.New([]string{}, t, valueName)
+	for _, c := range valueVars {
+		for i := 0; i < df.nrows; i++ {
+			rows = append(rows, i)
+			names = append(names, c)
+		}
+		value = value.Concat(series.New(df.Col(c), t, valueName))
+	}
+
+	var result []series.Series
+	for _, c := range idVars {
+		result = append(result, df.Col(c).Subset(rows))
+	}
+	result = append(result, series.New(names, series.String, varName), value)
+	return New(result...)
+}
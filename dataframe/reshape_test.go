@@ -0,0 +1,185 @@
+package dataframe
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_Pivot(t *testing.T) {
+	a := New(
+		series.New([]string{"2021", "2021", "2022", "2021", "2022", "2021"}, series.String, "year"),
+		series.New([]int{2, 1, 1, 2, 2, 1}, series.Int, "quarter"),
+		series.New([]string{"east", "west", "east", "east", "west", "west"}, series.String, "region"),
+		series.New([]int{10, 20, 30, 40, 50, 60}, series.Int, "sales"),
+		series.New([]float64{1, 2, 3, 4, 5, 6}, series.Float, "units"),
+	)
+	table := []struct {
+		index   []string
+		columns []string
+		values  []string
+		agg     AggregationType
+		expDf   DataFrame
+	}{
+		{
+			[]string{"year"},
+			[]string{"region"},
+			[]string{"sales"},
+			Aggregation_SUM,
+			New(
+				series.New([]string{"2021", "2022"}, series.String, "year"),
+				series.New([]float64{50, 30}, series.Float, "east"),
+				series.New([]float64{80, 50}, series.Float, "west"),
+			),
+		},
+		{
+			[]string{"year", "quarter"},
+			[]string{"region"},
+			[]string{"sales", "units"},
+			Aggregation_MAX,
+			New(
+				series.New([]string{"2021", "2021", "2022", "2022"}, series.String, "year"),
+				series.New([]int{1, 2, 1, 2}, series.Int, "quarter"),
+				series.New([]interface{}{nil, 40.0, 30.0, nil}, series.Float, "sales_east"),
+				series.New([]interface{}{60.0, nil, nil, 50.0}, series.Float, "sales_west"),
+				series.New([]interface{}{nil, 4.0, 3.0, nil}, series.Float, "units_east"),
+				series.New([]interface{}{6.0, nil, nil, 5.0}, series.Float, "units_west"),
+			),
+		},
+		{
+			[]string{"region"},
+			[]string{"year", "quarter"},
+			[]string{"units"},
+			Aggregation_COUNT,
+			New(
+				series.New([]string{"east", "west"}, series.String, "region"),
+				series.New([]interface{}{nil, 2.0}, series.Float, "2021_1"),
+				series.New([]interface{}{2.0, nil}, series.Float, "2021_2"),
+				series.New([]interface{}{1.0, nil}, series.Float, "2022_1"),
+				series.New([]interface{}{nil, 1.0}, series.Float, "2022_2"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := a.Pivot(tc.index, tc.columns, tc.values, tc.agg)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expDf.Names(), b.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, tc.expDf.Names(), b.Names())
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), b.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), b.Records())
+		}
+	}
+
+	if b := a.Pivot([]string{"year"}, []string{"missing"}, []string{"sales"}, Aggregation_SUM); b.Err == nil {
+		t.Errorf("Expected error on missing column")
+	}
+	if b := a.Pivot([]string{"year"}, nil, []string{"sales"}, Aggregation_SUM); b.Err == nil {
+		t.Errorf("Expected error on empty columns")
+	}
+}
+
+func TestDataFrame_Melt(t *testing.T) {
+	a := New(
+		series.New([]string{"x", "y"}, series.String, "id"),
+		series.New([]int{1, 2}, series.Int, "A"),
+		series.New([]float64{0.5, 1.5}, series.Float, "B"),
+		series.New([]interface{}{3, nil}, series.Int, "C"),
+		series.New([]string{"p", "q"}, series.String, "D"),
+	)
+	table := []struct {
+		idVars    []string
+		valueVars []string
+		varName   string
+		valueName string
+		expDf     DataFrame
+	}{
+		{
+			[]string{"id"},
+			[]string{"A", "C"},
+			"",
+			"",
+			New(
+				series.New([]string{"x", "y", "x", "y"}, series.String, "id"),
+				series.New([]string{"A", "A", "C", "C"}, series.String, "variable"),
+				series.New([]interface{}{1, 2, 3, nil}, series.Int, "value"),
+			),
+		},
+		{
+			[]string{"id"},
+			[]string{"A", "B"},
+			"col",
+			"val",
+			New(
+				series.New([]string{"x", "y", "x", "y"}, series.String, "id"),
+				series.New([]string{"A", "A", "B", "B"}, series.String, "col"),
+				series.New([]float64{1, 2, 0.5, 1.5}, series.Float, "val"),
+			),
+		},
+		{
+			[]string{"id", "A"},
+			nil,
+			"",
+			"",
+			New(
+				series.New([]string{"x", "y", "x", "y", "x", "y"}, series.String, "id"),
+				series.New([]int{1, 2, 1, 2, 1, 2}, series.Int, "A"),
+				series.New([]string{"B", "B", "C", "C", "D", "D"}, series.String, "variable"),
+				series.New([]interface{}{"0.500000", "1.500000", "3", nil, "p", "q"}, series.String, "value"),
+			),
+		},
+		{
+			nil,
+			[]string{"A"},
+			"",
+			"",
+			New(
+				series.New([]string{"A", "A"}, series.String, "variable"),
+				series.New([]int{1, 2}, series.Int, "value"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := a.Melt(tc.idVars, tc.valueVars, tc.varName, tc.valueName)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expDf.Names(), b.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, tc.expDf.Names(), b.Names())
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), b.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), b.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), b.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), b.Records())
+		}
+	}
+
+	// Melt and Pivot are inverses of each other.
+	long := a.Melt([]string{"id"}, []string{"A", "C"}, "", "")
+	wide := long.Pivot([]string{"id"}, []string{"variable"}, []string{"value"}, Aggregation_SUM)
+	expDf := New(
+		series.New([]string{"x", "y"}, series.String, "id"),
+		series.New([]float64{1, 2}, series.Float, "A"),
+		series.New([]float64{3, math.NaN()}, series.Float, "C"),
+	)
+	if !reflect.DeepEqual(expDf.Records(), wide.Records()) {
+		t.Errorf("Different values:\nA:%v\nB:%v", expDf.Records(), wide.Records())
+	}
+
+	if b := a.Melt([]string{"missing"}, nil, "", ""); b.Err == nil {
+		t.Errorf("Expected error on missing column")
+	}
+	if b := a.Melt(a.Names(), nil, "", ""); b.Err == nil {
+		t.Errorf("Expected error on no value columns")
+	}
+}
@@ -0,0 +1,113 @@
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/go-gota/gota/series"
+)
+
+// RollingWindow is used for rolling window calculations over several columns
+// of a DataFrame. See series.RollingWindow.
+type RollingWindow struct {
+	df      DataFrame
+	colidx  []int
+	window  int
+	options []series.RollingOption
+	err     error
+}
+
+// Rolling creates a RollingWindow over the given columns of the DataFrame. If
+// colnames is empty, all the Int and Float columns are used. The options are
+// passed to series.Rolling for every column.
+func (df DataFrame) Rolling(window int, colnames []string, options ...series.RollingOption) RollingWindow {
+	r := RollingWindow{df: df, window: window, options: options, err: df.Err}
+	if df.Err != nil {
+		return r
+	}
+	if len(colnames) == 0 {
+		for i, col := range df.columns {
+			if isNumeric(col.Type()) {
+				r.colidx = append(r.colidx, i)
+			}
+		}
+		return r
+	}
+	for _, c := range colnames {
+		i := df.colIndex(c)
+		if i < 0 {
+			r.err = fmt.Errorf("rolling: can't find column name: %s", c)
+			return r
+		}
+		r.colidx = append(r.colidx, i)
+	}
+	return r
+}
+
+// Mean returns the DataFrame with the rolling mean of the columns.
+func (r RollingWindow) Mean() DataFrame {
+	return r.apply(series.RollingWindow.Mean)
+}
+
+// StdDev returns the DataFrame with the rolling standard deviation of the
+// columns.
+func (r RollingWindow) StdDev() DataFrame {
+	return r.apply(series.RollingWindow.StdDev)
+}
+
+// Var returns the DataFrame with the rolling variance of the columns.
+func (r RollingWindow) Var() DataFrame {
+	return r.apply(series.RollingWindow.Var)
+}
+
+// Sum returns the DataFrame with the rolling sum of the columns.
+func (r RollingWindow) Sum() DataFrame {
+	return r.apply(series.RollingWindow.Sum)
+}
+
+// Min returns the DataFrame with the rolling minimum of the columns.
+func (r RollingWindow) Min() DataFrame {
+	return r.apply(series.RollingWindow.Min)
+}
+
+// Max returns the DataFrame with the rolling maximum of the columns.
+func (r RollingWindow) Max() DataFrame {
+	return r.apply(series.RollingWindow.Max)
+}
+
+// Median returns the DataFrame with the rolling median of the columns.
+func (r RollingWindow) Median() DataFrame {
+	return r.apply(series.RollingWindow.Median)
+}
+
+// Quantile returns the DataFrame with the rolling quantile p of the columns.
+func (r RollingWindow) Quantile(p float64) DataFrame {
+	return r.apply(func(w series.RollingWindow) series.Series {
+		return w.Quantile(p)
+	})
+}
+
+// Apply returns the DataFrame with the result of calling f with every window
+// of the columns.
+func (r RollingWindow) Apply(f func(series.Series) float64) DataFrame {
+	return r.apply(func(w series.RollingWindow) series.Series {
+		return w.Apply(f)
+	})
+}
+
+// apply replaces the rolling columns of the DataFrame with the result of f,
+// keeping their names. The rest of the columns are left unchanged.
+func (r RollingWindow) apply(f func(series.RollingWindow) series.Series) DataFrame {
+	if r.err != nil {
+		return DataFrame{Err: r.err}
+	}
+	df := r.df.Copy()
+	for _, i := range r.colidx {
+		s := f(df.columns[i].Rolling(r.window, r.options...))
+		if s.Err != nil {
+			return DataFrame{Err: fmt.Errorf("rolling: column %s: %v", df.columns[i].Name, s.Err)}
+		}
+		s.Name = df.columns[i].Name
+		df.columns[i] = s
+	}
+	return df
+}
@@ -0,0 +1,70 @@
+package dataframe
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_Rolling(t *testing.T) {
+	nan := math.NaN()
+	a := New(
+		series.New([]string{"a", "b", "c", "d"}, series.String, "day"),
+		series.New([]int{1, 2, 3, 4}, series.Int, "x"),
+		series.New([]float64{10, 20, 30, 40}, series.Float, "y"),
+	)
+	table := []struct {
+		df    DataFrame
+		expDf DataFrame
+	}{
+		{
+			a.Rolling(2, nil).Sum(),
+			New(
+				series.New([]string{"a", "b", "c", "d"}, series.String, "day"),
+				series.New([]float64{nan, 3, 5, 7}, series.Float, "x"),
+				series.New([]float64{nan, 30, 50, 70}, series.Float, "y"),
+			),
+		},
+		{
+			a.Rolling(3, []string{"y"}, series.MinPeriods(1), series.Center(true)).Mean(),
+			New(
+				series.New([]string{"a", "b", "c", "d"}, series.String, "day"),
+				series.New([]int{1, 2, 3, 4}, series.Int, "x"),
+				series.New([]float64{15, 20, 30, 35}, series.Float, "y"),
+			),
+		},
+		{
+			a.Rolling(2, []string{"x"}).Apply(func(s series.Series) float64 {
+				return s.Max() - s.Min()
+			}),
+			New(
+				series.New([]string{"a", "b", "c", "d"}, series.String, "day"),
+				series.New([]float64{nan, 1, 1, 1}, series.Float, "x"),
+				series.New([]float64{10, 20, 30, 40}, series.Float, "y"),
+			),
+		},
+	}
+	for i, tc := range table {
+		if tc.df.Err != nil {
+			t.Fatalf("Test: %d\nError: %v", i, tc.df.Err)
+		}
+		if !reflect.DeepEqual(tc.expDf.Names(), tc.df.Names()) {
+			t.Errorf("Test: %d\nDifferent colnames:\nA:%v\nB:%v", i, tc.expDf.Names(), tc.df.Names())
+		}
+		if !reflect.DeepEqual(tc.expDf.Types(), tc.df.Types()) {
+			t.Errorf("Test: %d\nDifferent types:\nA:%v\nB:%v", i, tc.expDf.Types(), tc.df.Types())
+		}
+		if !reflect.DeepEqual(tc.expDf.Records(), tc.df.Records()) {
+			t.Errorf("Test: %d\nDifferent values:\nA:%v\nB:%v", i, tc.expDf.Records(), tc.df.Records())
+		}
+	}
+
+	if b := a.Rolling(2, []string{"z"}).Mean(); b.Err == nil {
+		t.Errorf("Expected error for unknown column")
+	}
+	if b := a.Rolling(2, []string{"day"}).Mean(); b.Err != nil {
+		t.Errorf("Unexpected error: %v", b.Err)
+	}
+}
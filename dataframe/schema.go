@@ -0,0 +1,277 @@
+package dataframe
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-gota/gota/series"
+)
+
+// Schema describes the columns expected in a DataFrame, in order. It can be
+// given to any loader with WithSchema, and checked with Validate.
+type Schema []Field
+
+// Field describes a column of a Schema.
+type Field struct {
+	// Name of the column.
+	Name string
+
+	// Type of the column. If empty, any type is accepted.
+	Type series.Type
+
+	// Nullable allows missing elements in the column.
+	Nullable bool
+
+	// Constraints that every element of the column, except missing or NaN
+	// ones, must satisfy.
+	Constraints []Constraint
+}
+
+// Constraint checks an element of a column, returning an error describing the
+// violation if any.
+type Constraint func(e series.Element) error
+
+// Between returns a Constraint that requires the elements to be within
+// [min, max].
+func Between(min, max float64) Constraint {
+	return func(e series.Element) error {
+		if f := e.Float(); !(f >= min && f <= max) {
+			return fmt.Errorf("%s out of range [%v, %v]", e, min, max)
+		}
+		return nil
+	}
+}
+
+// OneOf returns a Constraint that requires the elements to be one of the
+// given values, compared as strings.
+func OneOf(values ...string) Constraint {
+	return func(e series.Element) error {
+		if findInStringSlice(e.String(), values) == -1 {
+			return fmt.Errorf("%q not one of %q", e.String(), values)
+		}
+		return nil
+	}
+}
+
+// MatchRegexp returns a Constraint that requires the elements, as strings, to
+// match re.
+func MatchRegexp(re *regexp.Regexp) Constraint {
+	return func(e series.Element) error {
+		if !re.MatchString(e.String()) {
+			return fmt.Errorf("%q doesn't match %s", e.String(), re)
+		}
+		return nil
+	}
+}
+
+// Names returns the names of the fields of the Schema.
+func (s Schema) Names() []string {
+	names := make([]string, len(s))
+	for i, f := range s {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// Violation is a failure of a DataFrame to conform to a field of a Schema.
+type Violation struct {
+	// Column is the name of the field.
+	Column string
+
+	// Rows holds the rows with the violation, and is empty for violations
+	// of the whole column.
+	Rows []int
+
+	// Reason describes the violation.
+	Reason string
+}
+
+func (v Violation) String() string {
+	if len(v.Rows) == 0 {
+		return fmt.Sprintf("column %q: %s", v.Column, v.Reason)
+	}
+	rows := fmt.Sprint(v.Rows)
+	if len(v.Rows) > 5 {
+		rows = strings.TrimSuffix(fmt.Sprint(v.Rows[:5]), "]") + " ...]"
+	}
+	return fmt.Sprintf("column %q: %s at rows %s", v.Column, v.Reason, rows)
+}
+
+// SchemaError is the error returned by Validate, with all the violations of
+// the Schema.
+type SchemaError struct {
+	Violations []Violation
+}
+
+func (e *SchemaError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.String()
+	}
+	return "schema: " + strings.Join(msgs, "; ")
+}
+
+// Schema returns the Schema of the DataFrame, with the name and type of every
+// column. Columns are nullable if they have missing elements.
+func (df DataFrame) Schema() Schema {
+	schema := make(Schema, df.ncols)
+	for i, col := range df.columns {
+		nullable := false
+		for _, null := range col.IsNull() {
+			if null {
+				nullable = true
+				break
+			}
+		}
+		schema[i] = Field{Name: col.Name, Type: col.Type(), Nullable: nullable}
+	}
+	return schema
+}
+
+// Validate checks that the DataFrame has every column of the Schema, with the
+// given type, without missing elements unless nullable and satisfying the
+// constraints. Columns that aren't in the Schema are ignored. If there are
+// violations, the returned error is a *SchemaError.
+func (df DataFrame) Validate(schema Schema) error {
+	if df.Err != nil {
+		return df.Err
+	}
+	var violations []Violation
+	for _, f := range schema {
+		idx := findInStringSlice(f.Name, df.Names())
+		if idx < 0 {
+			violations = append(violations, Violation{Column: f.Name, Reason: "missing column"})
+			continue
+		}
+		col := df.columns[idx]
+		if f.Type != "" && col.Type() != f.Type {
+			violations = append(violations, Violation{
+				Column: f.Name,
+				Reason: fmt.Sprintf("expected type %s, got %s", f.Type, col.Type()),
+			})
+			continue
+		}
+		if !f.Nullable {
+			var rows []int
+			for i, null := range col.IsNull() {
+				if null {
+					rows = append(rows, i)
+				}
+			}
+			if len(rows) > 0 {
+				violations = append(violations, Violation{
+					Column: f.Name,
+					Rows:   rows,
+					Reason: fmt.Sprintf("%d missing values", len(rows)),
+				})
+			}
+		}
+		for _, c := range f.Constraints {
+			var rows []int
+			var first error
+			for i := 0; i < col.Len(); i++ {
+				e := col.Elem(i)
+				if e.IsNA() {
+					continue
+				}
+				if err := c(e); err != nil {
+					if first == nil {
+						first = err
+					}
+					rows = append(rows, i)
+				}
+			}
+			if first != nil {
+				reason := first.Error()
+				if len(rows) > 1 {
+					reason = fmt.Sprintf("%s and %d more", reason, len(rows)-1)
+				}
+				violations = append(violations, Violation{Column: f.Name, Rows: rows, Reason: reason})
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return &SchemaError{Violations: violations}
+	}
+	return nil
+}
+
+// WithSchema sets the schema option for loadOptions. The columns of the
+// Schema are loaded with their types, which take precedence over the ones set
+// with WithTypes, and the loaded DataFrame is validated against it and
+// restricted to its columns, in order. Values of text formats, such as CSV,
+// JSON or XLSX, that can't be parsed as the type of their column are reported
+// as violations, also for nullable fields.
+func WithSchema(schema Schema) LoadOption {
+	return func(c *loadOptions) {
+		c.schema = schema
+	}
+}
+
+// columnType returns the type of a column set by the schema or types options.
+func (cfg loadOptions) columnType(name string) (series.Type, bool) {
+	for _, f := range cfg.schema {
+		if f.Name == name && f.Type != "" {
+			return f.Type, true
+		}
+	}
+	t, ok := cfg.types[name]
+	return t, ok
+}
+
+// parseFailures returns a Violation with the missing elements of col whose raw
+// value is present, as they couldn't be parsed as the type of col.
+func parseFailures(col series.Series, present func(i int) bool) (Violation, bool) {
+	var rows []int
+	for i, null := range col.IsNull() {
+		if null && present(i) {
+			rows = append(rows, i)
+		}
+	}
+	if len(rows) == 0 {
+		return Violation{}, false
+	}
+	return Violation{
+		Column: col.Name,
+		Rows:   rows,
+		Reason: fmt.Sprintf("%d values can't be parsed as %s", len(rows), col.Type()),
+	}, true
+}
+
+// conform validates a loaded DataFrame against the schema option, if any, and
+// selects its columns. The values that couldn't be parsed on load are reported
+// before the other violations of their column.
+func (cfg loadOptions) conform(df DataFrame, failures []Violation) DataFrame {
+	if cfg.schema == nil || df.Err != nil {
+		return df
+	}
+	var violations []Violation
+	err := df.Validate(cfg.schema)
+	if schemaErr, ok := err.(*SchemaError); ok {
+		violations = schemaErr.Violations
+	} else if err != nil {
+		return DataFrame{Err: err}
+	}
+	if len(violations) > 0 || len(failures) > 0 {
+		var merged []Violation
+		done := make(map[string]bool)
+		for _, f := range cfg.schema {
+			if done[f.Name] {
+				continue
+			}
+			done[f.Name] = true
+			for _, vs := range [][]Violation{failures, violations} {
+				for _, v := range vs {
+					if v.Column == f.Name {
+						merged = append(merged, v)
+					}
+				}
+			}
+		}
+		if len(merged) > 0 {
+			return DataFrame{Err: &SchemaError{Violations: merged}}
+		}
+	}
+	return df.Select(cfg.schema.Names())
+}
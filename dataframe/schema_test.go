@@ -0,0 +1,217 @@
+package dataframe
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/series"
+)
+
+func TestDataFrame_Validate(t *testing.T) {
+	df := New(
+		series.New([]interface{}{"a", "b", nil, "d"}, series.String, "A"),
+		series.New([]interface{}{1, 20, 3, nil}, series.Int, "B"),
+		series.New([]float64{0.5, 1.5, 2.5, 3.5}, series.Float, "C"),
+	)
+	table := []struct {
+		schema   Schema
+		expected []Violation
+	}{
+		{
+			df.Schema(),
+			nil,
+		},
+		{
+			Schema{
+				{Name: "C", Type: series.Float},
+				{Name: "A", Nullable: true, Constraints: []Constraint{OneOf("a", "b", "c")}},
+			},
+			[]Violation{
+				{Column: "A", Rows: []int{3}, Reason: `"d" not one of ["a" "b" "c"]`},
+			},
+		},
+		{
+			Schema{
+				{Name: "A", Type: series.String, Constraints: []Constraint{MatchRegexp(regexp.MustCompile("^[a-c]$"))}},
+				{Name: "B", Type: series.Int, Constraints: []Constraint{Between(0, 10)}},
+				{Name: "C", Type: series.Int},
+				{Name: "D", Type: series.Bool, Nullable: true},
+			},
+			[]Violation{
+				{Column: "A", Rows: []int{2}, Reason: "1 missing values"},
+				{Column: "A", Rows: []int{3}, Reason: `"d" doesn't match ^[a-c]$`},
+				{Column: "B", Rows: []int{3}, Reason: "1 missing values"},
+				{Column: "B", Rows: []int{1}, Reason: "20 out of range [0, 10]"},
+				{Column: "C", Reason: "expected type int, got float"},
+				{Column: "D", Reason: "missing column"},
+			},
+		},
+	}
+	for i, tc := range table {
+		err := df.Validate(tc.schema)
+		if tc.expected == nil {
+			if err != nil {
+				t.Errorf("Test: %d\nError: %v", i, err)
+			}
+			continue
+		}
+		var schemaErr *SchemaError
+		if !errors.As(err, &schemaErr) {
+			t.Errorf("Test: %d\nExpected SchemaError, got %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expected, schemaErr.Violations) {
+			t.Errorf("Test: %d\nExpected:\n%v\nReceived:\n%v", i, tc.expected, schemaErr.Violations)
+		}
+	}
+
+	err := df.Validate(Schema{{Name: "C", Constraints: []Constraint{Between(10, 20)}}, {Name: "E"}})
+	expected := `schema: column "C": 0.500000 out of range [10, 20] and 3 more at rows [0 1 2 3]; column "E": missing column`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error:\n%s\nReceived:\n%v", expected, err)
+	}
+}
+
+func TestDataFrame_Schema(t *testing.T) {
+	df := New(
+		series.New([]interface{}{"a", nil}, series.String, "A"),
+		series.New([]int{1, 2}, series.Int, "B"),
+	)
+	expected := Schema{
+		{Name: "A", Type: series.String, Nullable: true},
+		{Name: "B", Type: series.Int},
+	}
+	if got := df.Schema(); !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, got)
+	}
+	if names := expected.Names(); !reflect.DeepEqual([]string{"A", "B"}, names) {
+		t.Errorf("Expected names [A B], got %v", names)
+	}
+}
+
+func TestWithSchema(t *testing.T) {
+	schema := Schema{
+		{Name: "Amount", Type: series.Float},
+		{Name: "Code", Type: series.String, Nullable: true},
+	}
+	table := []struct {
+		csv     string
+		options []LoadOption
+		expDf   DataFrame
+	}{
+		{
+			"Code,Amount,Extra\n001,1,x\nNA,2,y\n",
+			[]LoadOption{WithSchema(schema)},
+			New(
+				series.New([]float64{1, 2}, series.Float, "Amount"),
+				series.New([]interface{}{"001", nil}, series.String, "Code"),
+			),
+		},
+		{
+			"a,b\n001,1\n002,2\n",
+			[]LoadOption{Names("Code", "Amount"), WithSchema(schema)},
+			New(
+				series.New([]float64{1, 2}, series.Float, "Amount"),
+				series.New([]string{"001", "002"}, series.String, "Code"),
+			),
+		},
+		{
+			"N,S\n1,a\n,b\n",
+			[]LoadOption{WithSchema(Schema{
+				{Name: "N", Type: series.Int, Nullable: true},
+				{Name: "S", Type: series.String},
+			})},
+			New(
+				series.New([]interface{}{1, nil}, series.Int, "N"),
+				series.New([]string{"a", "b"}, series.String, "S"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := ReadCSV(strings.NewReader(tc.csv), tc.options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	// Drifted files fail instead of being loaded with other types
+	for i, csv := range []string{
+		"Code,Amount\n001,1\n002,n/a\n",
+		"Code,Total\n001,1\n",
+	} {
+		b := ReadCSV(strings.NewReader(csv), WithSchema(schema))
+		var schemaErr *SchemaError
+		if !errors.As(b.Err, &schemaErr) {
+			t.Errorf("Test: %d\nExpected SchemaError, got %v", i, b.Err)
+		}
+	}
+
+	// Unparseable values are reported also on nullable fields, but not empty
+	// ones
+	b := ReadCSV(strings.NewReader("Code,N,Amount\n001,1,x\n,abc,2\nxyz,,3\n"),
+		WithSchema(Schema{
+			{Name: "N", Type: series.Int, Nullable: true},
+			{Name: "Amount", Type: series.Float},
+		}))
+	expected := []Violation{
+		{Column: "N", Rows: []int{1}, Reason: "1 values can't be parsed as int"},
+		{Column: "Amount", Rows: []int{0}, Reason: "1 values can't be parsed as float"},
+		{Column: "Amount", Rows: []int{0}, Reason: "1 missing values"},
+	}
+	var schemaErr *SchemaError
+	if !errors.As(b.Err, &schemaErr) {
+		t.Errorf("Expected SchemaError, got %v", b.Err)
+	} else if !reflect.DeepEqual(expected, schemaErr.Violations) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, schemaErr.Violations)
+	}
+
+	// The schema types are kept whatever the order of the options
+	for i, options := range [][]LoadOption{
+		{WithSchema(schema), WithTypes(map[string]series.Type{"Code": series.String})},
+		{WithTypes(map[string]series.Type{"Amount": series.Int}), WithSchema(schema)},
+	} {
+		b := ReadCSV(strings.NewReader("Code,Amount\n001,1\n"), options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		if types := b.Types(); !reflect.DeepEqual([]series.Type{series.Float, series.String}, types) {
+			t.Errorf("Test: %d\nExpected types [float string], got %v", i, types)
+		}
+	}
+
+	structs := []struct {
+		Code   string
+		Amount int
+	}{{"a", 1}}
+	b = LoadStructs(structs, WithSchema(schema))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	if types := b.Types(); !reflect.DeepEqual([]series.Type{series.Float, series.String}, types) {
+		t.Errorf("Expected types [float string], got %v", types)
+	}
+}
+
+func TestCSVChunkReader_WithSchema(t *testing.T) {
+	csv := "A,B,C\na,1,x\nb,2,y\nc,x,z\n"
+	schema := Schema{{Name: "B", Type: series.Int}, {Name: "A"}}
+	cr := NewCSVChunkReader(strings.NewReader(csv), 2, WithSchema(schema))
+	df, err := cr.Next()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	compareFrames(t, 0, New(
+		series.New([]int{1, 2}, series.Int, "B"),
+		series.New([]string{"a", "b"}, series.String, "A"),
+	), df)
+	if _, err := cr.Next(); err == nil || err == io.EOF {
+		t.Errorf("Expected schema error on second chunk, got %v", err)
+	}
+}
@@ -0,0 +1,441 @@
+package dataframe
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// ReadSQL reads the remaining rows of a result set and builds a DataFrame with
+// its columns. The rows are not closed. The types of the columns are mapped
+// from their database type names as follows:
+//
+//	BIGINT, INT8, BIGSERIAL                       // Int64
+//	UNSIGNED BIGINT, BIGINT UNSIGNED              // Uint64
+//	INTEGER, INT, INT4, SMALLINT, INT2, TINYINT   // Int
+//	FLOAT4                                        // Float32
+//	REAL, DOUBLE, FLOAT, FLOAT8, NUMERIC, DECIMAL // Float
+//	BOOLEAN, BOOL                                 // Bool
+//	TIMESTAMP, TIMESTAMPTZ, DATETIME, DATE        // Time
+//	CHAR, VARCHAR, TEXT and any other type        // String
+//
+// REAL is loaded as Float because it's a double precision type in some
+// databases, as SQLite. Other unsigned integer types, as MySQL's
+// "UNSIGNED INT", are loaded as Int.
+// Columns without a database type name, as computed columns in SQLite, are
+// typed from the Go type scanned by the driver. NULL values are loaded as
+// missing elements. The SelectColumns, Names and WithTypes options can be used
+// to choose, rename and convert the loaded columns.
+func ReadSQL(rows *sql.Rows, options ...LoadOption) DataFrame {
+	cfg := loadOptions{}
+	for _, option := range options {
+		option(&cfg)
+	}
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("read sql: %v", err)}
+	}
+
+	var indices []int
+	if cfg.columns == nil {
+		for i := range colTypes {
+			indices = append(indices, i)
+		}
+	} else {
+		for _, name := range cfg.columns {
+			idx := -1
+			for i, ct := range colTypes {
+				if ct.Name() == name {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				return DataFrame{Err: fmt.Errorf("read sql: can't find column name: %s", name)}
+			}
+			indices = append(indices, idx)
+		}
+	}
+	ncols := len(indices)
+	if cfg.names != nil && len(cfg.names) != ncols {
+		if len(cfg.names) > ncols {
+			return DataFrame{Err: fmt.Errorf("read sql: too many column names")}
+		}
+		return DataFrame{Err: fmt.Errorf("read sql: not enough column names")}
+	}
+
+	raw := make([]interface{}, len(colTypes))
+	dest := make([]interface{}, len(colTypes))
+	for i := range dest {
+		dest[i] = &raw[i]
+	}
+	values := make([][]interface{}, ncols)
+	for k := range values {
+		values[k] = []interface{}{}
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return DataFrame{Err: fmt.Errorf("read sql: %v", err)}
+		}
+		for k, i := range indices {
+			// Drivers return integers as int64 and text as []byte, which
+			// are converted to the types accepted by every element
+			v := raw[i]
+			switch x := v.(type) {
+			case []byte:
+				v = string(x)
+			case int64:
+				if int64(int(x)) == x {
+					v = int(x)
+				}
+			case uint64:
+				if x <= math.MaxInt {
+					v = int(x)
+				}
+			}
+			values[k] = append(values[k], v)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return DataFrame{Err: fmt.Errorf("read sql: %v", err)}
+	}
+
+	columns := make([]series.Series, ncols)
+	for k, i := range indices {
+		name := colTypes[i].Name()
+		if cfg.names != nil {
+			name = cfg.names[k]
+		}
+		t, ok := cfg.columnType(name)
+		if !ok {
+			t = sqlColumnType(colTypes[i], values[k])
+		}
+		columns[k] = series.New(values[k], t, name)
+		if err := columns[k].Err; err != nil {
+			return DataFrame{Err: fmt.Errorf("read sql: %v", err)}
+		}
+	}
+	if ncols == 0 {
+		return DataFrame{}
+	}
+	return cfg.conform(New(columns...), nil)
+}
+
+// sqlColumnType returns the series.Type used to load a column, from its
+// database type name or from its scanned values.
+func sqlColumnType(ct *sql.ColumnType, values []interface{}) series.Type {
+	name := strings.ToUpper(strings.TrimSpace(ct.DatabaseTypeName()))
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = strings.TrimSpace(name[:i])
+	}
+	unsigned := false
+	if n := strings.TrimPrefix(name, "UNSIGNED "); n != name {
+		name, unsigned = n, true
+	} else if n := strings.TrimSuffix(name, " UNSIGNED"); n != name {
+		name, unsigned = n, true
+	}
+	switch name {
+	case "BIGINT", "INT8", "BIGSERIAL":
+		if unsigned {
+			return series.Uint64
+		}
+		return series.Int64
+	case "INTEGER", "INT", "INT4", "SMALLINT", "INT2", "TINYINT", "MEDIUMINT", "SERIAL", "SMALLSERIAL":
+		return series.Int
+	case "FLOAT4":
+		return series.Float32
+	case "REAL", "DOUBLE", "DOUBLE PRECISION", "FLOAT", "FLOAT8", "NUMERIC", "DECIMAL":
+		return series.Float
+	case "BOOLEAN", "BOOL":
+		return series.Bool
+	case "TIMESTAMP", "TIMESTAMPTZ", "DATETIME", "DATE":
+		return series.Time
+	case "":
+	default:
+		return series.String
+	}
+
+	// Without a type name, use the values returned by the driver
+	t := series.Type("")
+	for _, v := range values {
+		var vt series.Type
+		switch v.(type) {
+		case nil:
+			continue
+		case int, int64, uint64:
+			vt = series.Int
+		case float64:
+			vt = series.Float
+		case bool:
+			vt = series.Bool
+		case time.Time:
+			vt = series.Time
+		default:
+			return series.String
+		}
+		switch {
+		case t == "" || t == vt:
+			t = vt
+		case t == series.Int && vt == series.Float, t == series.Float && vt == series.Int:
+			t = series.Float
+		default:
+			return series.String
+		}
+	}
+	if t == "" {
+		return sqlScanType(ct.ScanType())
+	}
+	return t
+}
+
+// sqlScanType returns the series.Type for the Go type used by a driver to scan
+// a column.
+func sqlScanType(st reflect.Type) series.Type {
+	if st == nil {
+		return series.String
+	}
+	switch st {
+	case reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullInt32{}), reflect.TypeOf(sql.NullInt16{}):
+		return series.Int
+	case reflect.TypeOf(sql.NullFloat64{}):
+		return series.Float
+	case reflect.TypeOf(sql.NullBool{}):
+		return series.Bool
+	case reflect.TypeOf(sql.NullTime{}), reflect.TypeOf(time.Time{}):
+		return series.Time
+	}
+	switch st.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return series.Int
+	case reflect.Float32, reflect.Float64:
+		return series.Float
+	case reflect.Bool:
+		return series.Bool
+	}
+	return series.String
+}
+
+// SQLWriteMode sets what WriteSQL does with the destination table.
+type SQLWriteMode int
+
+const (
+	// SQLCreate creates the table, failing if it already exists.
+	SQLCreate SQLWriteMode = iota
+	// SQLAppend inserts the rows into an existing table.
+	SQLAppend
+	// SQLReplace drops the table if it exists and creates it again.
+	SQLReplace
+)
+
+// WriteMode sets the sqlMode option for writeOptions. The default is
+// SQLCreate.
+func WriteMode(mode SQLWriteMode) WriteOption {
+	return func(c *writeOptions) {
+		c.sqlMode = mode
+	}
+}
+
+// BatchSize sets the batchSize option for writeOptions, the number of rows
+// inserted by every statement.
+func BatchSize(n int) WriteOption {
+	return func(c *writeOptions) {
+		c.batchSize = n
+	}
+}
+
+// Placeholder sets the placeholder option for writeOptions, which returns the
+// placeholder for the n-th parameter of a statement, counting from 1. The
+// default is "?", as used by SQLite and MySQL. See DollarPlaceholder.
+//
+// MySQL also needs its table and column names quoted with BacktickQuote.
+func Placeholder(f func(n int) string) WriteOption {
+	return func(c *writeOptions) {
+		c.placeholder = f
+	}
+}
+
+// DollarPlaceholder returns placeholders as "$1", "$2", ..., as used by
+// PostgreSQL.
+func DollarPlaceholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+// Quote sets the quote option for writeOptions, which quotes the table and
+// column names in the statements. The default is DoubleQuote.
+func Quote(f func(name string) string) WriteOption {
+	return func(c *writeOptions) {
+		c.quote = f
+	}
+}
+
+// DoubleQuote quotes a name with double quotes, as in standard SQL, SQLite and
+// PostgreSQL.
+func DoubleQuote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// BacktickQuote quotes a name with backticks, as in MySQL.
+func BacktickQuote(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// SQLExecer is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type SQLExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// WriteSQL writes the rows of the DataFrame into the given table, creating it
+// first unless the mode set with WriteMode is SQLAppend. The columns are
+// created with the following types:
+//
+//	String, Categorical  // TEXT
+//	Int, Int64           // BIGINT
+//	Int32                // INTEGER
+//	Uint64               // BIGINT
+//	Float                // DOUBLE PRECISION
+//	Float32              // FLOAT4
+//	Bool                 // BOOLEAN
+//	Time                 // TIMESTAMP
+//
+// Missing and NaN elements are written as NULL. Uint64 values above the
+// maximum BIGINT are rejected before writing anything, since database/sql
+// can't pass them to most drivers. The rows are inserted in batches, of
+// BatchSize rows or as many as fit in 999 parameters by default. Identifiers
+// are quoted as set with Quote, with double quotes by default. If db can begin
+// transactions, as *sql.DB and *sql.Conn, all the statements are run in a
+// single transaction.
+func (df DataFrame) WriteSQL(ctx context.Context, db SQLExecer, table string, options ...WriteOption) (err error) {
+	if df.Err != nil {
+		return df.Err
+	}
+	cfg := writeOptions{
+		sqlMode:     SQLCreate,
+		placeholder: func(int) string { return "?" },
+		quote:       DoubleQuote,
+	}
+	for _, option := range options {
+		option(&cfg)
+	}
+	if df.ncols == 0 {
+		return fmt.Errorf("write sql: no columns")
+	}
+	if cfg.batchSize <= 0 {
+		cfg.batchSize = max(1, 999/df.ncols)
+	}
+	for _, col := range df.columns {
+		if col.Type() != series.Uint64 {
+			continue
+		}
+		for i := 0; i < df.nrows; i++ {
+			if v, ok := col.Elem(i).Val().(uint64); ok && v > math.MaxInt64 {
+				return fmt.Errorf("write sql: column %s: value %d on row %d overflows BIGINT", col.Name, v, i)
+			}
+		}
+	}
+
+	if b, ok := db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	}); ok {
+		var tx *sql.Tx
+		tx, err = b.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("write sql: %v", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+				return
+			}
+			if err = tx.Commit(); err != nil {
+				err = fmt.Errorf("write sql: %v", err)
+			}
+		}()
+		db = tx
+	}
+
+	exec := func(query string, args ...interface{}) error {
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("write sql: %v", err)
+		}
+		return nil
+	}
+	name := cfg.quote(table)
+	switch cfg.sqlMode {
+	case SQLCreate, SQLReplace:
+		if cfg.sqlMode == SQLReplace {
+			if err := exec("DROP TABLE IF EXISTS " + name); err != nil {
+				return err
+			}
+		}
+		defs := make([]string, df.ncols)
+		for i, col := range df.columns {
+			defs[i] = cfg.quote(col.Name) + " " + sqlTypeName(col.Type())
+		}
+		if err := exec(fmt.Sprintf("CREATE TABLE %s (%s)", name, strings.Join(defs, ", "))); err != nil {
+			return err
+		}
+	case SQLAppend:
+	default:
+		return fmt.Errorf("write sql: unknown write mode %d", cfg.sqlMode)
+	}
+
+	colnames := make([]string, df.ncols)
+	for i, col := range df.columns {
+		colnames[i] = cfg.quote(col.Name)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", name, strings.Join(colnames, ", "))
+	for start := 0; start < df.nrows; start += cfg.batchSize {
+		end := min(start+cfg.batchSize, df.nrows)
+		var b strings.Builder
+		b.WriteString(prefix)
+		args := make([]interface{}, 0, (end-start)*df.ncols)
+		for i := start; i < end; i++ {
+			if i > start {
+				b.WriteString(", ")
+			}
+			b.WriteByte('(')
+			for j, col := range df.columns {
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				var v interface{}
+				if e := col.Elem(i); !e.IsNA() {
+					v = e.Val()
+				}
+				args = append(args, v)
+				b.WriteString(cfg.placeholder(len(args)))
+			}
+			b.WriteByte(')')
+		}
+		if err := exec(b.String(), args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqlTypeName returns the SQL type used to create a column of type t.
+func sqlTypeName(t series.Type) string {
+	switch t {
+	case series.Int, series.Int64, series.Uint64:
+		return "BIGINT"
+	case series.Int32:
+		return "INTEGER"
+	case series.Float:
+		return "DOUBLE PRECISION"
+	case series.Float32:
+		return "FLOAT4"
+	case series.Bool:
+		return "BOOLEAN"
+	case series.Time:
+		return "TIMESTAMP"
+	}
+	return "TEXT"
+}
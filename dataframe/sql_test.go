@@ -0,0 +1,374 @@
+package dataframe
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// fakeDB is the state of a database of the fake driver. Queries return its
+// columns and rows, while statements are recorded in execs.
+type fakeDB struct {
+	mu        sync.Mutex
+	columns   []fakeColumn
+	rows      [][]driver.Value
+	execs     []fakeExec
+	failOn    string
+	commits   int
+	rollbacks int
+}
+
+type fakeColumn struct {
+	name, typ string
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+var fakeDBs sync.Map
+
+func init() {
+	sql.Register("gotafake", fakeDriver{})
+}
+
+// openFake registers db and opens it through database/sql.
+func openFake(t *testing.T, db *fakeDB) *sql.DB {
+	t.Helper()
+	fakeDBs.Store(t.Name(), db)
+	conn, err := sql.Open("gotafake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	db, ok := fakeDBs.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown database %s", name)
+	}
+	return &fakeConn{db.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c.db, query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return &fakeTx{c.db}, nil }
+
+type fakeTx struct {
+	db *fakeDB
+}
+
+func (tx *fakeTx) Commit() error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+	tx.db.commits++
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+	tx.db.rollbacks++
+	return nil
+}
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	if s.db.failOn != "" && strings.HasPrefix(s.query, s.db.failOn) {
+		return nil, fmt.Errorf("exec failed")
+	}
+	s.db.execs = append(s.db.execs, fakeExec{s.query, args})
+	return driver.RowsAffected(0), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{db: s.db}, nil
+}
+
+type fakeRows struct {
+	db  *fakeDB
+	pos int
+}
+
+func (r *fakeRows) Columns() []string {
+	names := make([]string, len(r.db.columns))
+	for i, c := range r.db.columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.db.rows) {
+		return io.EOF
+	}
+	copy(dest, r.db.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func (r *fakeRows) ColumnTypeDatabaseTypeName(i int) string {
+	return r.db.columns[i].typ
+}
+
+func TestReadSQL(t *testing.T) {
+	ts := time.Date(2021, 10, 10, 12, 30, 0, 0, time.UTC)
+	db := openFake(t, &fakeDB{
+		columns: []fakeColumn{
+			{"id", "BIGINT"},
+			{"name", "VARCHAR(20)"},
+			{"score", "double precision"},
+			{"ratio", "FLOAT4"},
+			{"real", "REAL"},
+			{"ok", "BOOLEAN"},
+			{"ts", "TIMESTAMP"},
+			{"n", ""},
+			{"big", "bigint unsigned"},
+			{"small", "UNSIGNED INT(10)"},
+		},
+		rows: [][]driver.Value{
+			{int64(1), []byte("a"), 1.5, 0.25, 0.1, int64(1), ts, int64(3), uint64(math.MaxUint64), uint64(7)},
+			{int64(2), "b", nil, nil, nil, false, nil, 2.5, uint64(1), int64(8)},
+			{nil, nil, 3.0, 1.0, 2.0, nil, ts.Add(time.Hour), nil, nil, nil},
+		},
+	})
+	expected := New(
+		series.New([]interface{}{1, 2, nil}, series.Int64, "id"),
+		series.New([]interface{}{"a", "b", nil}, series.String, "name"),
+		series.New([]interface{}{1.5, nil, 3.0}, series.Float, "score"),
+		series.New([]interface{}{0.25, nil, 1.0}, series.Float32, "ratio"),
+		series.New([]interface{}{0.1, nil, 2.0}, series.Float, "real"),
+		series.New([]interface{}{true, false, nil}, series.Bool, "ok"),
+		series.New([]interface{}{ts, nil, ts.Add(time.Hour)}, series.Time, "ts"),
+		series.New([]interface{}{3, 2.5, nil}, series.Float, "n"),
+		series.New([]interface{}{uint64(math.MaxUint64), 1, nil}, series.Uint64, "big"),
+		series.New([]interface{}{7, 8, nil}, series.Int, "small"),
+	)
+	table := []struct {
+		options []LoadOption
+		expDf   DataFrame
+	}{
+		{
+			nil,
+			expected,
+		},
+		{
+			[]LoadOption{SelectColumns("ok", "id")},
+			expected.Select([]string{"ok", "id"}),
+		},
+		{
+			[]LoadOption{
+				SelectColumns("id"),
+				Names("X"),
+				WithTypes(map[string]series.Type{"X": series.String}),
+			},
+			New(series.New([]interface{}{"1", "2", nil}, series.String, "X")),
+		},
+	}
+	for i, tc := range table {
+		rows, err := db.Query("SELECT * FROM t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := ReadSQL(rows, tc.options...)
+		rows.Close()
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	for i, options := range [][]LoadOption{
+		{SelectColumns("Z")},
+		{Names("A", "B")},
+	} {
+		rows, err := db.Query("SELECT * FROM t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := ReadSQL(rows, options...); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error", i)
+		}
+		rows.Close()
+	}
+}
+
+func TestReadSQL_Empty(t *testing.T) {
+	db := openFake(t, &fakeDB{
+		columns: []fakeColumn{{"A", "INTEGER"}, {"B", "TEXT"}},
+	})
+	rows, err := db.Query("SELECT * FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	b := ReadSQL(rows)
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	if nrows, ncols := b.Dims(); nrows != 0 || ncols != 2 {
+		t.Errorf("Expected 0x2 DataFrame, got %dx%d", nrows, ncols)
+	}
+	if expected := []series.Type{series.Int, series.String}; !reflect.DeepEqual(expected, b.Types()) {
+		t.Errorf("Expected types %v, got %v", expected, b.Types())
+	}
+}
+
+func TestDataFrame_WriteSQL(t *testing.T) {
+	ts := time.Date(2021, 10, 10, 12, 30, 0, 0, time.UTC)
+	a := New(
+		series.New([]interface{}{"a", nil, "c"}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, math.NaN()}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+		series.New([]interface{}{ts, nil, nil}, series.Time, "E"),
+		series.New([]int32{1, 2, 3}, series.Int32, "F \"x\""),
+		series.New([]interface{}{0.5, nil, 2.0}, series.Float32, "G"),
+	)
+	createTable := `CREATE TABLE "t" ("A" TEXT, "B" BIGINT, "C" DOUBLE PRECISION, "D" BOOLEAN, "E" TIMESTAMP, "F ""x""" INTEGER, "G" FLOAT4)`
+	insert := `INSERT INTO "t" ("A", "B", "C", "D", "E", "F ""x""", "G") VALUES `
+	row := func(p ...string) string {
+		return "(" + strings.Join(p, ", ") + ")"
+	}
+	q := row("?", "?", "?", "?", "?", "?", "?")
+	args := [][]driver.Value{
+		{"a", int64(1), nil, true, ts, int64(1), 0.5},
+		{nil, int64(2), 1.5, nil, nil, int64(2), nil},
+		{"c", nil, nil, false, nil, int64(3), 2.0},
+	}
+	concat := func(rows ...[]driver.Value) []driver.Value {
+		var ret []driver.Value
+		for _, r := range rows {
+			ret = append(ret, r...)
+		}
+		return ret
+	}
+	table := []struct {
+		options  []WriteOption
+		expected []fakeExec
+	}{
+		{
+			nil,
+			[]fakeExec{
+				{createTable, nil},
+				{insert + q + ", " + q + ", " + q, concat(args...)},
+			},
+		},
+		{
+			[]WriteOption{WriteMode(SQLReplace), BatchSize(2)},
+			[]fakeExec{
+				{`DROP TABLE IF EXISTS "t"`, nil},
+				{createTable, nil},
+				{insert + q + ", " + q, concat(args[0], args[1])},
+				{insert + q, args[2]},
+			},
+		},
+		{
+			[]WriteOption{WriteMode(SQLAppend), BatchSize(1), Placeholder(DollarPlaceholder)},
+			[]fakeExec{
+				{insert + row("$1", "$2", "$3", "$4", "$5", "$6", "$7"), args[0]},
+				{insert + row("$1", "$2", "$3", "$4", "$5", "$6", "$7"), args[1]},
+				{insert + row("$1", "$2", "$3", "$4", "$5", "$6", "$7"), args[2]},
+			},
+		},
+		{
+			[]WriteOption{WriteMode(SQLReplace), Quote(BacktickQuote)},
+			[]fakeExec{
+				{"DROP TABLE IF EXISTS `t`", nil},
+				{"CREATE TABLE `t` (`A` TEXT, `B` BIGINT, `C` DOUBLE PRECISION, `D` BOOLEAN, `E` TIMESTAMP, `F \"x\"` INTEGER, `G` FLOAT4)", nil},
+				{"INSERT INTO `t` (`A`, `B`, `C`, `D`, `E`, `F \"x\"`, `G`) VALUES " + q + ", " + q + ", " + q, concat(args...)},
+			},
+		},
+	}
+	for i, tc := range table {
+		fake := &fakeDB{}
+		db := openFake(t, fake)
+		if err := a.WriteSQL(context.Background(), db, "t", tc.options...); err != nil {
+			t.Errorf("Test: %d\nError: %v", i, err)
+			continue
+		}
+		if len(fake.execs) != len(tc.expected) {
+			t.Errorf("Test: %d\nExpected %d statements, got %d: %v", i, len(tc.expected), len(fake.execs), fake.execs)
+			continue
+		}
+		for j, exec := range fake.execs {
+			if exec.query != tc.expected[j].query {
+				t.Errorf("Test: %d\nExpected query:\n%s\nReceived:\n%s", i, tc.expected[j].query, exec.query)
+			}
+			if len(exec.args) != 0 || len(tc.expected[j].args) != 0 {
+				if !reflect.DeepEqual(exec.args, tc.expected[j].args) {
+					t.Errorf("Test: %d\nExpected args:\n%v\nReceived:\n%v", i, tc.expected[j].args, exec.args)
+				}
+			}
+		}
+		if fake.commits != 1 || fake.rollbacks != 0 {
+			t.Errorf("Test: %d\nExpected 1 commit, got %d commits and %d rollbacks", i, fake.commits, fake.rollbacks)
+		}
+	}
+}
+
+func TestDataFrame_WriteSQL_Errors(t *testing.T) {
+	a := New(series.New([]int{1, 2}, series.Int, "A"))
+
+	fake := &fakeDB{failOn: "INSERT"}
+	db := openFake(t, fake)
+	if err := a.WriteSQL(context.Background(), db, "t"); err == nil {
+		t.Errorf("Expected error on failed insert")
+	}
+	if fake.commits != 0 || fake.rollbacks != 1 {
+		t.Errorf("Expected 1 rollback, got %d commits and %d rollbacks", fake.commits, fake.rollbacks)
+	}
+
+	if err := a.WriteSQL(context.Background(), db, "t", WriteMode(SQLWriteMode(10))); err == nil {
+		t.Errorf("Expected error on unknown write mode")
+	}
+	if err := (DataFrame{Err: fmt.Errorf("error")}).WriteSQL(context.Background(), db, "t"); err == nil {
+		t.Errorf("Expected error on DataFrame with errors")
+	}
+
+	// Uint64 values above the maximum BIGINT fail before any statement
+	fake = &fakeDB{}
+	db = openFake(t, fake)
+	b := New(series.New([]uint64{1, math.MaxUint64}, series.Uint64, "U"))
+	if err := b.WriteSQL(context.Background(), db, "t"); err == nil {
+		t.Errorf("Expected error on overflowing Uint64 value")
+	}
+	if len(fake.execs) != 0 {
+		t.Errorf("Expected no statements, got %v", fake.execs)
+	}
+}
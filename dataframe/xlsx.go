@@ -0,0 +1,731 @@
+package dataframe
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// ReadXLSX reads a sheet of an Excel workbook and builds a DataFrame with its
+// cells. If sheet is empty, the first sheet of the workbook is read. The
+// reader must also implement io.Seeker or have a `Size() int64` method, as
+// *os.File, *bytes.Reader and *io.SectionReader do.
+//
+// The header isn't detected: the first row is used as the header unless
+// HasHeader(false) is given, in which case the columns are named X0, X1... The
+// types of the columns are detected from the types of their cells:
+//
+//	whole numbers                   // Int
+//	other numbers                   // Float
+//	booleans                        // Bool
+//	dates and date-formatted cells  // Time
+//	text or mixed cells             // String
+//
+// Empty cells, error cells such as #N/A and text matching NaNValues are loaded
+// as missing elements. Dates are loaded in UTC. The Names, WithTypes,
+// DetectTypes and DefaultType options are also supported.
+func ReadXLSX(r io.ReaderAt, sheet string, options ...LoadOption) DataFrame {
+	wb, err := openXLSX(r)
+	if err != nil {
+		return DataFrame{Err: fmt.Errorf("read xlsx: %v", err)}
+	}
+	if len(wb.sheets) == 0 {
+		return DataFrame{Err: fmt.Errorf("read xlsx: workbook without sheets")}
+	}
+	target := wb.sheets[0]
+	if sheet != "" {
+		found := false
+		for _, s := range wb.sheets {
+			if s.name == sheet {
+				target, found = s, true
+				break
+			}
+		}
+		if !found {
+			return DataFrame{Err: fmt.Errorf("read xlsx: can't find sheet: %s", sheet)}
+		}
+	}
+	df := wb.loadSheet(target, options...)
+	if df.Err != nil {
+		return DataFrame{Err: fmt.Errorf("read xlsx: %v", df.Err)}
+	}
+	return df
+}
+
+// ReadXLSXSheets reads every sheet of an Excel workbook, as ReadXLSX, and
+// returns the DataFrames by sheet name. Sheets that can't be loaded, as empty
+// ones, are returned as DataFrames with the error, so the Err of every sheet
+// should be checked. If the workbook can't be read, the returned map holds a
+// single DataFrame with the error under the empty name.
+func ReadXLSXSheets(r io.ReaderAt, options ...LoadOption) map[string]DataFrame {
+	wb, err := openXLSX(r)
+	if err != nil {
+		return map[string]DataFrame{"": {Err: fmt.Errorf("read xlsx: %v", err)}}
+	}
+	dfs := make(map[string]DataFrame, len(wb.sheets))
+	for _, s := range wb.sheets {
+		df := wb.loadSheet(s, options...)
+		if df.Err != nil {
+			df = DataFrame{Err: fmt.Errorf("read xlsx: sheet %s: %v", s.name, df.Err)}
+		}
+		dfs[s.name] = df
+	}
+	return dfs
+}
+
+// readSeekerAt is implemented by the readers accepted by ReadXLSX.
+type readSeekerAt interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+}
+
+// readerAtSeeker adapts an io.ReaderAt to the interface required by the
+// workbook reader.
+func readerAtSeeker(r io.ReaderAt) (readSeekerAt, error) {
+	if rs, ok := r.(readSeekerAt); ok {
+		return rs, nil
+	}
+	if sized, ok := r.(interface{ Size() int64 }); ok {
+		return io.NewSectionReader(r, 0, sized.Size()), nil
+	}
+	return nil, fmt.Errorf("reader must implement io.Seeker or Size() int64")
+}
+
+// xlsxWorkbook holds the parts of a workbook shared by its sheets.
+type xlsxWorkbook struct {
+	files   map[string]*zip.File
+	sheets  []xlsxSheet
+	strings []string
+	dates   map[int]bool // cell styles with a date format
+	base    time.Time    // date of the serial number 0
+}
+
+type xlsxSheet struct {
+	name, path string
+}
+
+func openXLSX(r io.ReaderAt) (*xlsxWorkbook, error) {
+	rs, err := readerAtSeeker(r)
+	if err != nil {
+		return nil, err
+	}
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(rs, size)
+	if err != nil {
+		return nil, err
+	}
+	wb := &xlsxWorkbook{
+		files: make(map[string]*zip.File),
+		dates: make(map[int]bool),
+		base:  time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC),
+	}
+	for _, f := range zr.File {
+		wb.files[strings.TrimPrefix(f.Name, "/")] = f
+	}
+
+	// Locate the workbook through the package relationships
+	wbPath := "xl/workbook.xml"
+	var rels xlsxRelationships
+	if err := wb.decode("_rels/.rels", &rels, false); err != nil {
+		return nil, err
+	}
+	for _, rel := range rels.Relationships {
+		if strings.HasSuffix(rel.Type, "/officeDocument") {
+			wbPath = strings.TrimPrefix(rel.Target, "/")
+		}
+	}
+	var workbook struct {
+		Properties struct {
+			Date1904 string `xml:"date1904,attr"`
+		} `xml:"workbookPr"`
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := wb.decode(wbPath, &workbook, true); err != nil {
+		return nil, err
+	}
+	if d := workbook.Properties.Date1904; d == "1" || d == "true" {
+		wb.base = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	dir := path.Dir(wbPath)
+	relsPath := path.Join(dir, "_rels", path.Base(wbPath)+".rels")
+	rels = xlsxRelationships{}
+	if err := wb.decode(relsPath, &rels, true); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string)
+	var sharedStrings, styles string
+	for _, rel := range rels.Relationships {
+		target := path.Join(dir, rel.Target)
+		if strings.HasPrefix(rel.Target, "/") {
+			target = strings.TrimPrefix(rel.Target, "/")
+		}
+		targets[rel.ID] = target
+		switch {
+		case strings.HasSuffix(rel.Type, "/sharedStrings"):
+			sharedStrings = target
+		case strings.HasSuffix(rel.Type, "/styles"):
+			styles = target
+		}
+	}
+	for _, s := range workbook.Sheets {
+		// Chartsheets and other kinds of sheets can't be loaded
+		if target, ok := targets[s.ID]; ok && wb.files[target] != nil {
+			wb.sheets = append(wb.sheets, xlsxSheet{s.Name, target})
+		}
+	}
+
+	if sharedStrings != "" {
+		var sst struct {
+			Items []xlsxText `xml:"si"`
+		}
+		if err := wb.decode(sharedStrings, &sst, false); err != nil {
+			return nil, err
+		}
+		wb.strings = make([]string, len(sst.Items))
+		for i, si := range sst.Items {
+			wb.strings[i] = si.String()
+		}
+	}
+	if styles != "" {
+		var ss struct {
+			NumFmts []struct {
+				ID   int    `xml:"numFmtId,attr"`
+				Code string `xml:"formatCode,attr"`
+			} `xml:"numFmts>numFmt"`
+			CellXfs []struct {
+				NumFmtID int `xml:"numFmtId,attr"`
+			} `xml:"cellXfs>xf"`
+		}
+		if err := wb.decode(styles, &ss, false); err != nil {
+			return nil, err
+		}
+		custom := make(map[int]string)
+		for _, f := range ss.NumFmts {
+			custom[f.ID] = f.Code
+		}
+		for i, xf := range ss.CellXfs {
+			if code, ok := custom[xf.NumFmtID]; ok {
+				wb.dates[i] = isDateFormat(code)
+			} else {
+				wb.dates[i] = isDateFormatID(xf.NumFmtID)
+			}
+		}
+	}
+	return wb, nil
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is a shared or inline string, either plain or made of runs of rich
+// text.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+// decode unmarshals the XML file of the package with the given name into v.
+func (wb *xlsxWorkbook) decode(name string, v interface{}, required bool) error {
+	f, ok := wb.files[name]
+	if !ok {
+		if required {
+			return fmt.Errorf("missing %s", name)
+		}
+		return nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+// xlsxCell is the value of a cell, with its type.
+type xlsxCell struct {
+	kind byte // 0 for empty cells, or one of 'n', 's', 'b' and 'd'
+	str  string
+	num  float64
+	date time.Time
+}
+
+// value returns the value of the cell to load into a column of type t.
+func (c xlsxCell) value(t series.Type) interface{} {
+	if t == series.String || t == series.Categorical {
+		switch c.kind {
+		case 'n':
+			return strconv.FormatFloat(c.num, 'f', -1, 64)
+		case 'b':
+			return strconv.FormatBool(c.num != 0)
+		case 'd':
+			return c.date.Format(time.RFC3339Nano)
+		}
+		return c.str
+	}
+	switch c.kind {
+	case 'n':
+		if c.num == math.Trunc(c.num) && math.Abs(c.num) <= 1<<53 {
+			return int(c.num)
+		}
+		return c.num
+	case 'b':
+		return c.num != 0
+	case 'd':
+		return c.date
+	}
+	return c.str
+}
+
+func (wb *xlsxWorkbook) loadSheet(s xlsxSheet, options ...LoadOption) DataFrame {
+	cfg := defaultLoadOptions()
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	var ws struct {
+		Rows []struct {
+			R     int `xml:"r,attr"`
+			Cells []struct {
+				R      string   `xml:"r,attr"`
+				T      string   `xml:"t,attr"`
+				S      int      `xml:"s,attr"`
+				V      string   `xml:"v"`
+				Inline xlsxText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := wb.decode(s.path, &ws, true); err != nil {
+		return DataFrame{Err: err}
+	}
+
+	// Place the cells with a value by their reference, keeping the row and
+	// column gaps but dropping the leading and trailing empty ones
+	cells := make(map[[2]int]xlsxCell)
+	minRow, maxRow, minCol, maxCol := math.MaxInt, -1, math.MaxInt, -1
+	row := 0
+	for _, r := range ws.Rows {
+		if r.R > 0 {
+			row = r.R - 1
+		}
+		col := 0
+		for _, c := range r.Cells {
+			if c.R != "" {
+				rr, cc, err := parseCellRef(c.R)
+				if err != nil {
+					return DataFrame{Err: err}
+				}
+				row, col = rr, cc
+			}
+			cell, err := wb.cell(c.T, c.S, c.V, c.Inline)
+			if err != nil {
+				return DataFrame{Err: fmt.Errorf("cell %s: %v", cellRef(row, col), err)}
+			}
+			if cell.kind != 0 {
+				cells[[2]int{row, col}] = cell
+				minRow, maxRow = min(minRow, row), max(maxRow, row)
+				minCol, maxCol = min(minCol, col), max(maxCol, col)
+			}
+			col++
+		}
+		row++
+	}
+	if maxRow < 0 {
+		return DataFrame{Err: fmt.Errorf("empty DataFrame")}
+	}
+	ncols := maxCol - minCol + 1
+
+	headers := make([]string, ncols)
+	if cfg.hasHeader {
+		for j := range headers {
+			headers[j] = cells[[2]int{minRow, minCol + j}].value(series.String).(string)
+		}
+		minRow++
+		if minRow > maxRow {
+			return DataFrame{Err: fmt.Errorf("empty DataFrame")}
+		}
+	}
+	if cfg.names != nil {
+		if len(cfg.names) != ncols {
+			if len(cfg.names) > ncols {
+				return DataFrame{Err: fmt.Errorf("too many column names")}
+			}
+			return DataFrame{Err: fmt.Errorf("not enough column names")}
+		}
+		headers = cfg.names
+	}
+
+	nrows := maxRow - minRow + 1
+	columns := make([]series.Series, ncols)
+	var failures []Violation
+	for j := range columns {
+		col := make([]xlsxCell, nrows)
+		for i := range col {
+			cell := cells[[2]int{minRow + i, minCol + j}]
+			if cell.kind == 's' && findInStringSlice(cell.str, cfg.nanValues) != -1 {
+				cell = xlsxCell{}
+			}
+			col[i] = cell
+		}
+		t, ok := cfg.columnType(headers[j])
+		if !ok {
+			t = cfg.defaultType
+			if cfg.detectTypes {
+				if detected, ok := xlsxColumnType(col); ok {
+					t = detected
+				}
+			}
+		}
+		values := make([]interface{}, nrows)
+		for i, cell := range col {
+			if cell.kind != 0 {
+				values[i] = cell.value(t)
+			}
+		}
+		columns[j] = series.New(values, t, headers[j])
+		if columns[j].Err != nil {
+			return DataFrame{Err: columns[j].Err}
+		}
+		if cfg.schema != nil {
+			present := func(i int) bool { return col[i].kind != 0 && !(col[i].kind == 's' && col[i].str == "") }
+			if v, ok := parseFailures(columns[j], present); ok {
+				failures = append(failures, v)
+			}
+		}
+	}
+	return cfg.conform(New(columns...), failures)
+}
+
+// cell converts the raw contents of a cell with the given type and style.
+func (wb *xlsxWorkbook) cell(t string, style int, v string, inline xlsxText) (xlsxCell, error) {
+	switch t {
+	case "s":
+		if v == "" {
+			return xlsxCell{}, nil
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 || i >= len(wb.strings) {
+			return xlsxCell{}, fmt.Errorf("invalid shared string %q", v)
+		}
+		return xlsxCell{kind: 's', str: wb.strings[i]}, nil
+	case "inlineStr":
+		return xlsxCell{kind: 's', str: inline.String()}, nil
+	case "str":
+		return xlsxCell{kind: 's', str: v}, nil
+	case "e":
+		// Error values, as #N/A or #DIV/0!, are missing
+		return xlsxCell{}, nil
+	case "b":
+		if v == "" {
+			return xlsxCell{}, nil
+		}
+		cell := xlsxCell{kind: 'b'}
+		if v == "1" || v == "true" {
+			cell.num = 1
+		}
+		return cell, nil
+	case "d":
+		if v == "" {
+			return xlsxCell{}, nil
+		}
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+			if d, err := time.Parse(layout, v); err == nil {
+				return xlsxCell{kind: 'd', date: d.UTC()}, nil
+			}
+		}
+		return xlsxCell{}, fmt.Errorf("invalid date %q", v)
+	}
+	if v == "" {
+		return xlsxCell{}, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return xlsxCell{}, fmt.Errorf("invalid number %q", v)
+	}
+	if wb.dates[style] {
+		return xlsxCell{kind: 'd', date: fromExcelSerial(f, wb.base)}, nil
+	}
+	return xlsxCell{kind: 'n', num: f}, nil
+}
+
+// xlsxColumnType returns the type of a column from the kinds of its cells. It
+// returns false if all the cells are empty.
+func xlsxColumnType(col []xlsxCell) (series.Type, bool) {
+	kind := byte(0)
+	whole := true
+	for _, c := range col {
+		if c.kind == 0 {
+			continue
+		}
+		if kind != 0 && c.kind != kind {
+			return series.String, true
+		}
+		kind = c.kind
+		if c.kind == 'n' && (c.num != math.Trunc(c.num) || math.Abs(c.num) > 1<<53) {
+			whole = false
+		}
+	}
+	switch kind {
+	case 0:
+		return "", false
+	case 'n':
+		if whole {
+			return series.Int, true
+		}
+		return series.Float, true
+	case 'b':
+		return series.Bool, true
+	case 'd':
+		return series.Time, true
+	}
+	return series.String, true
+}
+
+// isDateFormatID returns whether the built-in number format with the given id
+// displays dates or times.
+func isDateFormatID(id int) bool {
+	return (id >= 14 && id <= 22) || (id >= 45 && id <= 47)
+}
+
+// isDateFormat returns whether a custom number format displays dates or times,
+// ignoring literal text, colors and locales.
+func isDateFormat(code string) bool {
+	quoted, bracket := false, false
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[':
+			bracket = true
+		case c == ']':
+			bracket = false
+		case bracket:
+		case c == '\\' || c == '_' || c == '*':
+			i++
+		case strings.IndexByte("ymdhsYMDHS", c) >= 0:
+			return true
+		}
+	}
+	return false
+}
+
+// fromExcelSerial converts a serial date number, the days since base, to a
+// time rounded to the millisecond.
+func fromExcelSerial(f float64, base time.Time) time.Time {
+	ms := math.Round(f * 24 * 60 * 60 * 1000)
+	return base.Add(time.Duration(ms) * time.Millisecond)
+}
+
+// toExcelSerial converts a time to a serial date number, the days since base.
+func toExcelSerial(t time.Time, base time.Time) float64 {
+	return float64(t.Sub(base)) / float64(24*time.Hour)
+}
+
+// parseCellRef parses a cell reference as "B3", returning zero-based row and
+// column numbers.
+func parseCellRef(ref string) (row, col int, err error) {
+	i := 0
+	for i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z' {
+		col = col*26 + int(ref[i]-'A'+1)
+		i++
+	}
+	row, err = strconv.Atoi(ref[i:])
+	if i == 0 || err != nil || row < 1 {
+		return 0, 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return row - 1, col - 1, nil
+}
+
+// cellRef returns the reference of the cell with zero-based row and column
+// numbers.
+func cellRef(row, col int) string {
+	var letters []byte
+	for col++; col > 0; col = (col - 1) / 26 {
+		letters = append([]byte{byte('A' + (col-1)%26)}, letters...)
+	}
+	return string(letters) + strconv.Itoa(row+1)
+}
+
+// WriteXLSX writes the DataFrame to the given io.Writer as an Excel workbook
+// with a single sheet. If sheet is empty, it is named "Sheet1". The cells are
+// written as follows:
+//
+//	String, Categorical  // text
+//	numeric types        // numbers
+//	Bool                 // booleans
+//	Time                 // dates, in UTC
+//
+// Missing, NaN and infinite elements are written as empty cells. The header is
+// written unless WriteHeader(false) is given.
+func (df DataFrame) WriteXLSX(w io.Writer, sheet string, options ...WriteOption) error {
+	if df.Err != nil {
+		return df.Err
+	}
+	cfg := writeOptions{
+		writeHeader: true,
+	}
+	for _, option := range options {
+		option(&cfg)
+	}
+	if sheet == "" {
+		sheet = "Sheet1"
+	}
+	if len([]rune(sheet)) > 31 || strings.ContainsAny(sheet, `[]:*?/\`) {
+		return fmt.Errorf("write xlsx: invalid sheet name %q", sheet)
+	}
+
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	row := 0
+	if cfg.writeHeader {
+		b.WriteString(`<row r="1">`)
+		for j, col := range df.columns {
+			writeXLSXString(&b, cellRef(0, j), col.Name)
+		}
+		b.WriteString(`</row>`)
+		row++
+	}
+	base := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < df.nrows; i++ {
+		fmt.Fprintf(&b, `<row r="%d">`, row+1)
+		for j, col := range df.columns {
+			e := col.Elem(i)
+			if e.IsNA() {
+				continue
+			}
+			ref := cellRef(row, j)
+			switch col.Type() {
+			case series.String, series.Categorical:
+				writeXLSXString(&b, ref, e.String())
+			case series.Bool:
+				v := "0"
+				if bv, _ := e.Bool(); bv {
+					v = "1"
+				}
+				fmt.Fprintf(&b, `<c r="%s" t="b"><v>%s</v></c>`, ref, v)
+			case series.Time:
+				t, err := e.Time()
+				if err != nil {
+					continue
+				}
+				serial := strconv.FormatFloat(toExcelSerial(t, base), 'f', -1, 64)
+				fmt.Fprintf(&b, `<c r="%s" s="1"><v>%s</v></c>`, ref, serial)
+			case series.Float, series.Float32:
+				f := e.Float()
+				if math.IsInf(f, 0) {
+					continue
+				}
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(f, 'g', -1, 64))
+			default:
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, e.String())
+			}
+		}
+		b.WriteString(`</row>`)
+		row++
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+
+	var name strings.Builder
+	xml.EscapeText(&name, []byte(sheet))
+	files := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbookXML, name.String())},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+		{"xl/worksheets/sheet1.xml", b.String()},
+	}
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("write xlsx: %v", err)
+		}
+		if _, err := io.WriteString(fw, f.body); err != nil {
+			return fmt.Errorf("write xlsx: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("write xlsx: %v", err)
+	}
+	return nil
+}
+
+// writeXLSXString writes an inline string cell.
+func writeXLSXString(b *strings.Builder, ref, s string) {
+	fmt.Fprintf(b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+	xml.EscapeText(b, []byte(s))
+	b.WriteString(`</t></is></c>`)
+}
+
+const xlsxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookXML = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+	`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles defines the default cell style and a date style, used by Time
+// cells.
+const xlsxStyles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+	`</styleSheet>`
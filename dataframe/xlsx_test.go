@@ -0,0 +1,233 @@
+package dataframe
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-gota/gota/series"
+)
+
+// buildXLSX returns a workbook made of the given files, keyed by path.
+func buildXLSX(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, body := range files {
+		fw, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(body))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+// testWorkbook has two sheets, with shared and rich text strings, a custom and
+// a built-in date format, error cells and gaps between the cells.
+func testWorkbook(t *testing.T) *bytes.Reader {
+	return buildXLSX(t, map[string]string{
+		"_rels/.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`,
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Sales" sheetId="1" r:id="rId1"/><sheet name="Other" sheetId="2" r:id="rId2"/><sheet name="Empty" sheetId="3" r:id="rId5"/></sheets>
+</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet2.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>
+<Relationship Id="rId4" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rId5" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet3.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Country</t></si><si><t>Date</t></si><si><r><t>Sp</t></r><r><t>ain</t></r></si><si><t>NA</t></si>
+</sst>`,
+		"xl/styles.xml": `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts><numFmt numFmtId="164" formatCode="[$-409]dd/mm/yyyy;@"/><numFmt numFmtId="165" formatCode="&quot;day&quot; 0.00"/></numFmts>
+<cellXfs><xf numFmtId="0"/><xf numFmtId="164"/><xf numFmtId="14"/><xf numFmtId="165"/></cellXfs>
+</styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="2"><c r="B2" t="s"><v>0</v></c><c r="C2" t="s"><v>1</v></c><c r="D2" t="inlineStr"><is><t>Amount</t></is></c><c r="E2" t="str"><v>Paid</v></c><c r="F2" t="inlineStr"><is><t>Mixed</t></is></c></row>
+<row r="3"><c r="B3" t="s"><v>2</v></c><c r="C3" s="1"><v>44479.5</v></c><c r="D3" s="3"><v>1.5</v></c><c r="E3" t="b"><v>1</v></c><c r="F3"><v>7</v></c></row>
+<row r="4"><c r="B4" t="s"><v>3</v></c><c r="C4" s="2"><v>44480</v></c><c r="D4" t="e"><v>#N/A</v></c><c r="E4" t="b"><v>0</v></c><c r="F4" t="inlineStr"><is><t>x</t></is></c></row>
+<row r="6"><c r="B6" t="inlineStr"><is><t>France</t></is></c><c r="D6"><v>3</v></c><c r="G6" s="1"/></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row><c t="inlineStr"><is><t>A</t></is></c><c t="inlineStr"><is><t>B</t></is></c></row>
+<row><c><v>1</v></c><c t="d"><v>2021-10-10T12:00:00Z</v></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet3.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+	})
+}
+
+func TestReadXLSX(t *testing.T) {
+	date := time.Date(2021, 10, 10, 0, 0, 0, 0, time.UTC)
+	sales := New(
+		series.New([]interface{}{"Spain", nil, nil, "France"}, series.String, "Country"),
+		series.New([]interface{}{date.Add(12 * time.Hour), date.Add(24 * time.Hour), nil, nil}, series.Time, "Date"),
+		series.New([]interface{}{1.5, nil, nil, 3.0}, series.Float, "Amount"),
+		series.New([]interface{}{true, false, nil, nil}, series.Bool, "Paid"),
+		series.New([]interface{}{"7", "x", nil, nil}, series.String, "Mixed"),
+	)
+	table := []struct {
+		sheet   string
+		options []LoadOption
+		expDf   DataFrame
+	}{
+		{
+			"",
+			nil,
+			sales,
+		},
+		{
+			"Sales",
+			[]LoadOption{
+				Names("A", "B", "C", "D", "E"),
+				WithTypes(map[string]series.Type{"C": series.String, "B": series.String}),
+			},
+			New(
+				series.New([]interface{}{"Spain", nil, nil, "France"}, series.String, "A"),
+				series.New([]interface{}{"2021-10-10T12:00:00Z", "2021-10-11T00:00:00Z", nil, nil}, series.String, "B"),
+				series.New([]interface{}{"1.5", nil, nil, "3"}, series.String, "C"),
+				series.New([]interface{}{true, false, nil, nil}, series.Bool, "D"),
+				series.New([]interface{}{"7", "x", nil, nil}, series.String, "E"),
+			),
+		},
+		{
+			"Other",
+			nil,
+			New(
+				series.New([]int{1}, series.Int, "A"),
+				series.New([]time.Time{date.Add(12 * time.Hour)}, series.Time, "B"),
+			),
+		},
+		{
+			"Other",
+			[]LoadOption{HasHeader(false), DetectTypes(false)},
+			New(
+				series.New([]string{"A", "1"}, series.String, "X0"),
+				series.New([]string{"B", "2021-10-10T12:00:00Z"}, series.String, "X1"),
+			),
+		},
+	}
+	for i, tc := range table {
+		b := ReadXLSX(testWorkbook(t), tc.sheet, tc.options...)
+		if b.Err != nil {
+			t.Errorf("Test: %d\nError: %v", i, b.Err)
+			continue
+		}
+		compareFrames(t, i, tc.expDf, b)
+	}
+
+	for i, sheet := range []string{"Missing", "Empty"} {
+		if b := ReadXLSX(testWorkbook(t), sheet); b.Err == nil {
+			t.Errorf("Test: %d\nExpected error on sheet %s", i, sheet)
+		}
+	}
+	if b := ReadXLSX(testWorkbook(t), "", Names("A")); b.Err == nil {
+		t.Errorf("Expected error on not enough column names")
+	}
+	if b := ReadXLSX(bytes.NewReader([]byte("not xlsx")), ""); b.Err == nil {
+		t.Errorf("Expected error on invalid file")
+	}
+}
+
+func TestReadXLSXSheets(t *testing.T) {
+	dfs := ReadXLSXSheets(testWorkbook(t))
+	table := []struct {
+		sheet string
+		nrows int
+		err   bool
+	}{
+		{"Sales", 4, false},
+		{"Other", 1, false},
+		{"Empty", 0, true},
+	}
+	if len(dfs) != len(table) {
+		t.Errorf("Expected %d sheets, got %d", len(table), len(dfs))
+	}
+	for i, tc := range table {
+		df, ok := dfs[tc.sheet]
+		if !ok {
+			t.Errorf("Test: %d\nMissing sheet %s", i, tc.sheet)
+			continue
+		}
+		if (df.Err != nil) != tc.err {
+			t.Errorf("Test: %d\nUnexpected error on sheet %s: %v", i, tc.sheet, df.Err)
+		}
+		if df.Nrow() != tc.nrows {
+			t.Errorf("Test: %d\nExpected %d rows in sheet %s, got %d", i, tc.nrows, tc.sheet, df.Nrow())
+		}
+	}
+
+	dfs = ReadXLSXSheets(bytes.NewReader([]byte("not xlsx")))
+	if len(dfs) != 1 || dfs[""].Err == nil {
+		t.Errorf("Expected error on invalid file")
+	}
+}
+
+func TestDataFrame_WriteXLSX(t *testing.T) {
+	a := New(
+		series.New([]interface{}{"a", nil, " <c&d> "}, series.String, "A"),
+		series.New([]interface{}{1, 2, nil}, series.Int, "B"),
+		series.New([]interface{}{nil, 1.5, -2.25}, series.Float, "C"),
+		series.New([]interface{}{true, nil, false}, series.Bool, "D"),
+		series.New([]interface{}{"2021-10-10T12:30:00.5Z", "2021-10-11", nil}, series.Time, "E"),
+		series.New([]int64{-1 << 40, 0, 1}, series.Int64, "F"),
+	)
+	buf := new(bytes.Buffer)
+	if err := a.WriteXLSX(buf, "Data & more"); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	b := ReadXLSX(bytes.NewReader(buf.Bytes()), "Data & more", WithTypes(map[string]series.Type{"F": series.Int64}))
+	if b.Err != nil {
+		t.Fatalf("Error: %v", b.Err)
+	}
+	compareFrames(t, 0, a, b)
+
+	buf.Reset()
+	if err := a.WriteXLSX(buf, "", WriteHeader(false)); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	dfs := ReadXLSXSheets(bytes.NewReader(buf.Bytes()), HasHeader(false))
+	if df, ok := dfs["Sheet1"]; !ok || df.Nrow() != 3 {
+		t.Errorf("Expected 3 rows without header in Sheet1, got %v", dfs)
+	}
+
+	if err := a.WriteXLSX(new(bytes.Buffer), "a/b"); err == nil {
+		t.Errorf("Expected error on invalid sheet name")
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	table := []struct {
+		row, col int
+		ref      string
+	}{
+		{0, 0, "A1"},
+		{9, 25, "Z10"},
+		{0, 26, "AA1"},
+		{1, 701, "ZZ2"},
+		{2, 702, "AAA3"},
+	}
+	for i, tc := range table {
+		if got := cellRef(tc.row, tc.col); got != tc.ref {
+			t.Errorf("Test: %d\nExpected %s, got %s", i, tc.ref, got)
+		}
+		row, col, err := parseCellRef(tc.ref)
+		if err != nil || !reflect.DeepEqual([]int{tc.row, tc.col}, []int{row, col}) {
+			t.Errorf("Test: %d\nExpected %d %d, got %d %d (%v)", i, tc.row, tc.col, row, col, err)
+		}
+	}
+	for _, ref := range []string{"", "A", "1", "A0", "a1"} {
+		if _, _, err := parseCellRef(ref); err == nil {
+			t.Errorf("Expected error on reference %q", ref)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/go-gota/gota
 
-go 1.16
+go 1.21
 
 require (
 	golang.org/x/net v0.0.0-20210423184538-5f58ad60dda6
@@ -0,0 +1,231 @@
+package series
+
+import (
+	"fmt"
+)
+
+// Add returns the element-wise sum of the Series and other, which can be a
+// Series of the same length or a scalar. The type of the result follows
+// PromoteTypes, except that int and float64 scalars take the type of the Series
+// when they can be represented by it, so that adding 1 to an Int32 Series
+// returns an Int32 Series. Elements are missing when any of the operands is
+// missing, while NaN values propagate as in float arithmetic. String and
+// Categorical Series are concatenated with other strings. The result keeps the
+// name of the Series.
+func (s Series) Add(other interface{}) Series {
+	return s.arithmetic("add", other)
+}
+
+// Sub returns the element-wise difference between the Series and other. See
+// Add for the supported operands.
+func (s Series) Sub(other interface{}) Series {
+	return s.arithmetic("sub", other)
+}
+
+// Mul returns the element-wise product of the Series and other. See Add for
+// the supported operands.
+func (s Series) Mul(other interface{}) Series {
+	return s.arithmetic("mul", other)
+}
+
+// Div returns the element-wise quotient of the Series and other, which is a
+// Float Series unless both are Float32. See Add for the supported operands.
+func (s Series) Div(other interface{}) Series {
+	return s.arithmetic("div", other)
+}
+
+func (s Series) arithmetic(op string, other interface{}) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	o, weak, err := arithmeticOperand(other)
+	if err != nil {
+		s.Err = fmt.Errorf("%s: %v", op, err)
+		return s
+	}
+	_, isSeries := other.(Series)
+	if isSeries && o.Len() != s.Len() {
+		s.Err = fmt.Errorf("%s: length mismatch %d != %d", op, s.Len(), o.Len())
+		return s
+	}
+	t, err := s.arithmeticType(op, o, weak)
+	if err != nil {
+		s.Err = fmt.Errorf("%s: %v", op, err)
+		return s
+	}
+	x := s.elements.Elem
+	y := func(i int) Element {
+		if !isSeries {
+			return o.elements.Elem(0)
+		}
+		return o.elements.Elem(i)
+	}
+
+	n := s.Len()
+	ret := Series{Name: s.Name, t: t}
+	switch {
+	case t == String:
+		elements := make(stringElements, n)
+		for i := range elements {
+			a, b := x(i), y(i)
+			if a.IsNull() || b.IsNull() {
+				elements[i].null = true
+				continue
+			}
+			elements[i].e = a.String() + b.String()
+		}
+		ret.elements = elements
+	case t == Int || t == Int64 || t == Int32:
+		get := func(e Element) (int64, bool) {
+			if e.IsNA() {
+				return 0, false
+			}
+			return toInt64(e.Val())
+		}
+		values, nulls := arithmeticLane(n, x, y, get, arithmeticOp[int64](op))
+		ret.elements = arithmeticElements(t, values, nulls)
+	case t == Uint64:
+		get := func(e Element) (uint64, bool) {
+			if e.IsNA() {
+				return 0, false
+			}
+			return toUint64(e.Val())
+		}
+		values, nulls := arithmeticLane(n, x, y, get, arithmeticOp[uint64](op))
+		ret.elements = arithmeticElements(t, values, nulls)
+	default:
+		get := func(e Element) (float64, bool) {
+			if e.IsNull() {
+				return 0, false
+			}
+			return e.Float(), true
+		}
+		values, nulls := arithmeticLane(n, x, y, get, arithmeticOp[float64](op))
+		ret.elements = arithmeticElements(t, values, nulls)
+	}
+	return ret
+}
+
+// arithmeticOperand returns other as a Series, and whether it is an untyped
+// int or float64 scalar.
+func arithmeticOperand(other interface{}) (Series, bool, error) {
+	switch v := other.(type) {
+	case Series:
+		if v.Err != nil {
+			return v, false, fmt.Errorf("argument has errors: %v", v.Err)
+		}
+		return v, false, nil
+	case int:
+		return New(v, Int, ""), true, nil
+	case float64:
+		return New(v, Float, ""), true, nil
+	case int64:
+		return New(v, Int64, ""), false, nil
+	case int32:
+		return New(v, Int32, ""), false, nil
+	case uint64:
+		return New(v, Uint64, ""), false, nil
+	case float32:
+		return New(v, Float32, ""), false, nil
+	case string:
+		return New(v, String, ""), false, nil
+	case Element:
+		return New([]Element{v}, v.Type(), ""), false, nil
+	}
+	return Series{}, false, fmt.Errorf("unsupported operand type %T", other)
+}
+
+// arithmeticType returns the type of the result of op between s and o.
+func (s Series) arithmeticType(op string, o Series, weak bool) (Type, error) {
+	str := func(t Type) bool { return t == String || t == Categorical }
+	if op == "add" && str(s.t) && str(o.t) {
+		return String, nil
+	}
+	ot := o.t
+	if weak && numeric(s.t) {
+		switch {
+		case o.t == Int && (s.t == Int || s.t == Int64 || s.t == Int32 || s.t == Uint64):
+			// Untyped int scalars take the type of the Series if they fit
+			if !New(o, s.t, "").elements.Elem(0).IsNull() {
+				ot = s.t
+			}
+		case o.t == Float && s.t == Float32:
+			ot = Float32
+		}
+	}
+	t, err := PromoteTypes(s.t, ot)
+	if err != nil {
+		return "", fmt.Errorf("unsupported types %s and %s", s.t, o.t)
+	}
+	if op == "div" && t != Float32 {
+		t = Float
+	}
+	return t, nil
+}
+
+type arithmeticValue interface {
+	int64 | uint64 | float64
+}
+
+// arithmeticLane applies f to the values of the pairs of elements returned by
+// x and y, converted with get. Pairs with a missing element are missing.
+func arithmeticLane[V arithmeticValue](n int, x, y func(int) Element, get func(Element) (V, bool), f func(a, b V) V) ([]V, []bool) {
+	values := make([]V, n)
+	nulls := make([]bool, n)
+	for i := range values {
+		a, okA := get(x(i))
+		b, okB := get(y(i))
+		if !okA || !okB {
+			nulls[i] = true
+			continue
+		}
+		values[i] = f(a, b)
+	}
+	return values, nulls
+}
+
+func arithmeticOp[V arithmeticValue](op string) func(a, b V) V {
+	switch op {
+	case "add":
+		return func(a, b V) V { return a + b }
+	case "sub":
+		return func(a, b V) V { return a - b }
+	case "mul":
+		return func(a, b V) V { return a * b }
+	}
+	return func(a, b V) V { return a / b }
+}
+
+// arithmeticElements builds the elements of type t with the given values.
+// Values are converted as in Go, so integers wrap around on overflow.
+func arithmeticElements[V arithmeticValue](t Type, values []V, nulls []bool) Elements {
+	switch t {
+	case Int:
+		elements := make(intElements, len(values))
+		for i, v := range values {
+			elements[i] = intElement{int(v), nulls[i]}
+		}
+		return elements
+	case Float:
+		elements := make(floatElements, len(values))
+		for i, v := range values {
+			elements[i] = floatElement{float64(v), nulls[i]}
+		}
+		return elements
+	case Int64:
+		return sizedFromValues[int64](values, nulls)
+	case Int32:
+		return sizedFromValues[int32](values, nulls)
+	case Uint64:
+		return sizedFromValues[uint64](values, nulls)
+	}
+	return sizedFromValues[float32](values, nulls)
+}
+
+func sizedFromValues[T sizedValue, V arithmeticValue](values []V, nulls []bool) sizedElements[T] {
+	elements := make(sizedElements[T], len(values))
+	for i, v := range values {
+		elements[i] = sizedElement[T]{T(v), nulls[i]}
+	}
+	return elements
+}
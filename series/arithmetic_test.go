@@ -0,0 +1,157 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Arithmetic(t *testing.T) {
+	ints := New([]interface{}{1, 2, nil, 4}, Int, "A")
+	floats := New([]interface{}{0.5, math.NaN(), 1.5, nil}, Float, "B")
+	table := []struct {
+		result   Series
+		t        Type
+		expected []string
+		nulls    []bool
+	}{
+		{
+			ints.Add(ints),
+			Int,
+			[]string{"2", "4", "NaN", "8"},
+			[]bool{false, false, true, false},
+		},
+		{
+			ints.Add(floats),
+			Float,
+			[]string{"1.500000", "nan", "NaN", "NaN"},
+			[]bool{false, false, true, true},
+		},
+		{
+			ints.Sub(1),
+			Int,
+			[]string{"0", "1", "NaN", "3"},
+			[]bool{false, false, true, false},
+		},
+		{
+			ints.Mul(2.5),
+			Float,
+			[]string{"2.500000", "5.000000", "NaN", "10.000000"},
+			[]bool{false, false, true, false},
+		},
+		{
+			ints.Div(2),
+			Float,
+			[]string{"0.500000", "1.000000", "NaN", "2.000000"},
+			[]bool{false, false, true, false},
+		},
+		{
+			floats.Sub(ints),
+			Float,
+			[]string{"-0.500000", "nan", "NaN", "NaN"},
+			[]bool{false, false, true, true},
+		},
+		{
+			New([]int32{1, 2}, Int32, "C").Add(1),
+			Int32,
+			[]string{"2", "3"},
+			[]bool{false, false},
+		},
+		{
+			New([]int32{1, 2}, Int32, "C").Add(1 << 40),
+			Int,
+			[]string{"1099511627777", "1099511627778"},
+			[]bool{false, false},
+		},
+		{
+			New([]int32{1, 2}, Int32, "C").Mul(New([]int64{3, 4}, Int64, "D")),
+			Int64,
+			[]string{"3", "8"},
+			[]bool{false, false},
+		},
+		{
+			New([]uint64{18446744073709551615, 1}, Uint64, "C").Sub(1),
+			Uint64,
+			[]string{"18446744073709551614", "0"},
+			[]bool{false, false},
+		},
+		{
+			New([]uint64{10, 1}, Uint64, "C").Sub(-1),
+			Float,
+			[]string{"11.000000", "2.000000"},
+			[]bool{false, false},
+		},
+		{
+			New([]float32{0.5, 1}, Float32, "C").Div(2.0),
+			Float32,
+			[]string{"0.250000", "0.500000"},
+			[]bool{false, false},
+		},
+		{
+			New([]int32{16777217, 1}, Int32, "C").Add(New([]float32{0.5, 1}, Float32, "D")),
+			Float,
+			[]string{"16777217.500000", "2.000000"},
+			[]bool{false, false},
+		},
+		{
+			New([]float32{0.5, 1}, Float32, "C").Add(float64(1)).Add(int64(1)),
+			Float,
+			[]string{"2.500000", "3.000000"},
+			[]bool{false, false},
+		},
+		{
+			New([]interface{}{"a", nil}, String, "C").Add("!"),
+			String,
+			[]string{"a!", "NaN"},
+			[]bool{false, true},
+		},
+		{
+			New([]string{"x", "y"}, Categorical, "C").Add(Strings([]string{"1", "2"})),
+			String,
+			[]string{"x1", "y2"},
+			[]bool{false, false},
+		},
+		{
+			ints.Add(ints.Elem(3)),
+			Int,
+			[]string{"5", "6", "NaN", "8"},
+			[]bool{false, false, true, false},
+		},
+	}
+	for testnum, test := range table {
+		if err := test.result.Err; err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+			continue
+		}
+		if got := test.result.Type(); got != test.t {
+			t.Errorf("Test:%v\nExpected type %v, got %v", testnum, test.t, got)
+		}
+		if got := test.result.Records(); !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got)
+		}
+		if got := test.result.IsNull(); !reflect.DeepEqual(test.nulls, got) {
+			t.Errorf("Test:%v\nExpected nulls:\n%v\nReceived:\n%v", testnum, test.nulls, got)
+		}
+	}
+	if name := ints.Add(floats).Name; name != "A" {
+		t.Errorf("Expected name A, got %v", name)
+	}
+}
+
+func TestSeries_Arithmetic_Errors(t *testing.T) {
+	ints := Ints([]int{1, 2})
+	table := []Series{
+		ints.Add(Ints([]int{1, 2, 3})),
+		ints.Sub("a"),
+		ints.Mul(Bools([]bool{true, false})),
+		ints.Div([]int{1, 2}),
+		Strings([]string{"a", "b"}).Sub("a"),
+		ints.Add(Series{Err: fmt.Errorf("error")}),
+	}
+	for testnum, s := range table {
+		if s.Err == nil {
+			t.Errorf("Test:%v\nExpected error", testnum)
+		}
+	}
+}
@@ -135,6 +135,11 @@ func BenchmarkSeries_New(b *testing.B) {
 			generateInts(100000),
 			series.Float,
 		},
+		{
+			"[]string(100000)_Categorical",
+			generateStrings(100000),
+			series.Categorical,
+		},
 	}
 	for _, test := range table {
 		b.Run(test.name, func(b *testing.B) {
@@ -167,6 +172,10 @@ func BenchmarkSeries_Copy(b *testing.B) {
 			"[]int(100000)_Float",
 			series.Floats(generateInts(100000)),
 		},
+		{
+			"[]int(100000)_Categorical",
+			series.New(generateInts(100000), series.Categorical, ""),
+		},
 	}
 	for _, test := range table {
 		b.Run(test.name, func(b *testing.B) {
@@ -204,6 +213,11 @@ func BenchmarkSeries_Subset(b *testing.B) {
 			generateIntsN(10000, 2),
 			series.Floats(generateInts(100000)),
 		},
+		{
+			"[]int(100000)_Categorical",
+			generateIntsN(10000, 2),
+			series.New(generateInts(100000), series.Categorical, ""),
+		},
 	}
 	for _, test := range table {
 		b.Run(test.name, func(b *testing.B) {
@@ -256,3 +270,28 @@ func BenchmarkSeries_Set(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkTyped_Sum(b *testing.B) {
+	rand.Seed(100)
+	data := generateFloats(100000)
+	s := series.Floats(data)
+	typed := series.NewTyped(data, "")
+	b.Run("Series", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0.0
+			for j := 0; j < s.Len(); j++ {
+				sum += s.Elem(j).Float()
+			}
+		}
+	})
+	b.Run("Typed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0.0
+			for j, v := range typed.Values() {
+				if typed.IsValid(j) {
+					sum += v
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,272 @@
+package series
+
+import (
+	"fmt"
+	"math"
+)
+
+// EWMWindow is used for exponentially weighted window calculations. The weight
+// of every element decays by a factor of 1-alpha with every position, including
+// the positions of missing elements, which are otherwise skipped. NaN values
+// produce NaN from then on.
+type EWMWindow struct {
+	windowOptions
+	series Series
+	err    error
+}
+
+// Alpha sets the smoothing factor of an exponentially weighted window, which
+// must be in (0, 1].
+func Alpha(alpha float64) RollingOption {
+	return func(o *windowOptions) {
+		o.alpha = alpha
+	}
+}
+
+// Span sets the smoothing factor of an exponentially weighted window from its
+// span, as alpha = 2 / (span + 1). The span must be at least 1.
+func Span(span float64) RollingOption {
+	return Alpha(2 / (span + 1))
+}
+
+// HalfLife sets the smoothing factor of an exponentially weighted window from
+// the number of positions it takes for a weight to decay to half, as
+// alpha = 1 - exp(-ln(2) / halfLife). The half-life must be positive.
+func HalfLife(halfLife float64) RollingOption {
+	return Alpha(1 - math.Exp(-math.Ln2/halfLife))
+}
+
+// Adjust sets whether the weights of an exponentially weighted window are
+// normalized over the elements seen so far, which is the default. If false,
+// the mean is computed recursively as y[t] = (1-alpha)*y[t-1] + alpha*x[t].
+func Adjust(b bool) RollingOption {
+	return func(o *windowOptions) {
+		o.adjust = b
+	}
+}
+
+// EWM creates a new EWMWindow. The smoothing factor must be set with Alpha,
+// Span or HalfLife.
+func (s Series) EWM(options ...RollingOption) EWMWindow {
+	w := EWMWindow{
+		windowOptions: windowOptions{minPeriods: 1, adjust: true},
+		series:        s,
+	}
+	for _, option := range options {
+		option(&w.windowOptions)
+	}
+	if !(w.alpha > 0 && w.alpha <= 1) {
+		w.err = fmt.Errorf("ewm: alpha must be in (0, 1], got %v", w.alpha)
+	}
+	return w
+}
+
+// Mean returns the exponentially weighted mean.
+func (w EWMWindow) Mean() Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	x, valid := w.series.ewmValues()
+	ret := make([]float64, len(x))
+	oldWtFactor := 1 - w.alpha
+	newWt := 1.0
+	if !w.adjust {
+		newWt = w.alpha
+	}
+	weighted := math.NaN()
+	oldWt := 1.0
+	nobs := 0
+	for i := range x {
+		if valid[i] {
+			nobs++
+		}
+		switch {
+		case nobs > 1 || (nobs == 1 && !valid[i]):
+			oldWt *= oldWtFactor
+			if valid[i] {
+				if weighted != x[i] {
+					weighted = (oldWt*weighted + newWt*x[i]) / (oldWt + newWt)
+				}
+				if w.adjust {
+					oldWt += newWt
+				} else {
+					oldWt = 1
+				}
+			}
+		case valid[i]:
+			weighted = x[i]
+		}
+		ret[i] = w.result(nobs, weighted)
+	}
+	return New(ret, Float, "Mean")
+}
+
+// Sum returns the exponentially weighted sum, where the weight of every element
+// is (1-alpha) to the power of its distance to the current position.
+func (w EWMWindow) Sum() Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	x, valid := w.series.ewmValues()
+	ret := make([]float64, len(x))
+	sum := 0.0
+	nobs := 0
+	for i := range x {
+		sum *= 1 - w.alpha
+		if valid[i] {
+			nobs++
+			sum += x[i]
+		}
+		ret[i] = w.result(nobs, sum)
+	}
+	return New(ret, Float, "Sum")
+}
+
+// Var returns the exponentially weighted variance, with bias correction.
+func (w EWMWindow) Var() Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	return New(w.cov(w.series, w.series, false), Float, "Var")
+}
+
+// StdDev returns the exponentially weighted standard deviation, with bias
+// correction.
+func (w EWMWindow) StdDev() Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	ret := w.cov(w.series, w.series, false)
+	for i := range ret {
+		ret[i] = math.Sqrt(ret[i])
+	}
+	return New(ret, Float, "StdDev")
+}
+
+// Cov returns the exponentially weighted covariance with other, with bias
+// correction. Both Series must have the same length, and only the positions
+// where both elements are present are used.
+func (w EWMWindow) Cov(other Series) Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	if other.Len() != w.series.Len() {
+		return w.series.floatError(fmt.Errorf("ewm: length mismatch"))
+	}
+	return New(w.cov(w.series, other, false), Float, "Cov")
+}
+
+// Corr returns the exponentially weighted correlation with other. Both Series
+// must have the same length, and only the positions where both elements are
+// present are used.
+func (w EWMWindow) Corr(other Series) Series {
+	if w.err != nil {
+		return w.series.floatError(w.err)
+	}
+	if other.Len() != w.series.Len() {
+		return w.series.floatError(fmt.Errorf("ewm: length mismatch"))
+	}
+	// Only the positions where both elements are present are used
+	xs, ys := w.series.Copy(), other.Copy()
+	for i := 0; i < xs.Len(); i++ {
+		if xs.elements.Elem(i).IsNull() || ys.elements.Elem(i).IsNull() {
+			xs.elements.Elem(i).Set(nil)
+			ys.elements.Elem(i).Set(nil)
+		}
+	}
+	cov := w.cov(xs, ys, true)
+	varX := w.cov(xs, xs, true)
+	varY := w.cov(ys, ys, true)
+	ret := make([]float64, len(cov))
+	for i := range ret {
+		ret[i] = cov[i] / math.Sqrt(varX[i]*varY[i])
+	}
+	return New(ret, Float, "Corr")
+}
+
+// cov computes the exponentially weighted covariance of two Series of the same
+// length, without bias correction if bias is true.
+func (w EWMWindow) cov(a, b Series, bias bool) []float64 {
+	x, validX := a.ewmValues()
+	y, validY := b.ewmValues()
+	ret := make([]float64, len(x))
+	oldWtFactor := 1 - w.alpha
+	newWt := 1.0
+	if !w.adjust {
+		newWt = w.alpha
+	}
+	meanX, meanY := math.NaN(), math.NaN()
+	cov := 0.0
+	sumWt, sumWt2, oldWt := 1.0, 1.0, 1.0
+	nobs := 0
+	for i := range x {
+		valid := validX[i] && validY[i]
+		if valid {
+			nobs++
+		}
+		switch {
+		case nobs > 1 || (nobs == 1 && !valid):
+			sumWt *= oldWtFactor
+			sumWt2 *= oldWtFactor * oldWtFactor
+			oldWt *= oldWtFactor
+			if valid {
+				oldMeanX, oldMeanY := meanX, meanY
+				if meanX != x[i] {
+					meanX = (oldWt*oldMeanX + newWt*x[i]) / (oldWt + newWt)
+				}
+				if meanY != y[i] {
+					meanY = (oldWt*oldMeanY + newWt*y[i]) / (oldWt + newWt)
+				}
+				cov = (oldWt*(cov+(oldMeanX-meanX)*(oldMeanY-meanY)) +
+					newWt*(x[i]-meanX)*(y[i]-meanY)) / (oldWt + newWt)
+				sumWt += newWt
+				sumWt2 += newWt * newWt
+				oldWt += newWt
+				if !w.adjust {
+					sumWt /= oldWt
+					sumWt2 /= oldWt * oldWt
+					oldWt = 1
+				}
+			}
+		case valid:
+			meanX, meanY = x[i], y[i]
+		}
+		value := cov
+		if !bias {
+			numerator := sumWt * sumWt
+			denominator := numerator - sumWt2
+			value = math.NaN()
+			if denominator > 0 {
+				value = numerator / denominator * cov
+			}
+		}
+		if math.IsNaN(meanX) || math.IsNaN(meanY) {
+			value = math.NaN()
+		}
+		ret[i] = w.result(nobs, value)
+	}
+	return ret
+}
+
+// result returns value if there are enough observations, or NaN otherwise.
+func (w EWMWindow) result(nobs int, value float64) float64 {
+	if nobs == 0 || nobs < w.minPeriods {
+		return math.NaN()
+	}
+	return value
+}
+
+// ewmValues returns the float values of the Series and whether every element is
+// present.
+func (s Series) ewmValues() ([]float64, []bool) {
+	x := make([]float64, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range x {
+		e := s.elements.Elem(i)
+		if !e.IsNull() {
+			x[i] = e.Float()
+			valid[i] = true
+		}
+	}
+	return x, valid
+}
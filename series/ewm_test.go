@@ -0,0 +1,70 @@
+package series
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSeries_EWM(t *testing.T) {
+	nan := math.NaN()
+	s := Ints([]int{1, 2, 3})
+	nulls := New([]interface{}{1, nil, 3}, Float, "")
+	tests := []struct {
+		received Series
+		expected Series
+	}{
+		{s.EWM(Alpha(0.5)).Mean(), Floats([]float64{1, 1.666667, 2.428571})},
+		{s.EWM(Span(3)).Mean(), Floats([]float64{1, 1.666667, 2.428571})},
+		{s.EWM(HalfLife(1)).Mean(), Floats([]float64{1, 1.666667, 2.428571})},
+		{s.EWM(Alpha(0.5), Adjust(false)).Mean(), Floats([]float64{1, 1.5, 2.25})},
+		{s.EWM(Alpha(0.5), MinPeriods(2)).Mean(), Floats([]float64{nan, 1.666667, 2.428571})},
+		{nulls.EWM(Alpha(0.5)).Mean(), Floats([]float64{1, 1, 2.6})},
+		{New([]interface{}{nil, 2, 4}, Int, "").EWM(Alpha(0.5)).Mean(), Floats([]float64{nan, 2, 3.333333})},
+		{s.EWM(Alpha(0.5)).Sum(), Floats([]float64{1, 2.5, 4.25})},
+		{nulls.EWM(Alpha(0.5)).Sum(), Floats([]float64{1, 0.5, 3.25})},
+		{s.EWM(Alpha(0.5)).Var(), Floats([]float64{nan, 0.5, 0.928571})},
+		{s.EWM(Alpha(0.5)).StdDev(), Floats([]float64{nan, 0.707107, 0.963624})},
+		{s.EWM(Alpha(0.5)).Cov(Ints([]int{2, 4, 6})), Floats([]float64{nan, 1, 1.857143})},
+		{s.EWM(Alpha(0.5)).Corr(Ints([]int{2, 4, 6})), Floats([]float64{nan, 1, 1})},
+		{s.EWM(Alpha(0.5)).Corr(Ints([]int{-1, -2, -3})), Floats([]float64{nan, -1, -1})},
+		{Floats([]float64{1, nan, 3}).EWM(Alpha(0.5)).Mean(), Floats([]float64{1, nan, nan})},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.received)
+	}
+
+	for testnum, received := range []Series{
+		s.EWM().Mean(),
+		s.EWM(Alpha(1.5)).Var(),
+		s.EWM(Span(0)).Sum(),
+		s.EWM(HalfLife(-1)).StdDev(),
+		s.EWM(Alpha(0.5)).Corr(Ints([]int{1})),
+		s.EWM(Alpha(0.5)).Cov(Ints([]int{1})),
+	} {
+		if received.Err == nil {
+			t.Errorf("Test:%v\nExpected error", testnum)
+		}
+	}
+}
+
+func TestSeries_Expanding(t *testing.T) {
+	nan := math.NaN()
+	s := New([]interface{}{1, nil, 3, 4}, Int, "")
+	tests := []struct {
+		received Series
+		expected Series
+	}{
+		{s.Expanding().Sum(), Floats([]float64{1, 1, 4, 8})},
+		{s.Expanding().Mean(), Floats([]float64{1, 1, 2, 2.666667})},
+		{s.Expanding().Var(), Floats([]float64{nan, nan, 2, 2.333333})},
+		{s.Expanding().StdDev(), Floats([]float64{nan, nan, 1.414214, 1.527525})},
+		{s.Expanding().Max(), Floats([]float64{1, 1, 3, 4})},
+		{s.Expanding().Median(), Floats([]float64{1, 1, 2, 3})},
+		{s.Expanding(MinPeriods(2)).Sum(), Floats([]float64{nan, nan, 4, 8})},
+		{s.Expanding(Center(true)).Sum(), Floats([]float64{1, 1, 4, 8})},
+		{Floats([]float64{}).Expanding().Mean(), Floats([]float64{})},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.received)
+	}
+}
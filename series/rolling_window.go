@@ -1,52 +1,311 @@
 package series
 
-// RollingWindow is used for rolling window calculations.
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// RollingWindow is used for rolling window calculations. Missing elements are
+// skipped, and windows with NaN values produce NaN.
 type RollingWindow struct {
 	window int
+	windowOptions
 	series Series
 }
 
+// windowOptions holds the options of rolling, expanding and exponentially
+// weighted windows.
+type windowOptions struct {
+	minPeriods int
+	center     bool
+	alpha      float64
+	adjust     bool
+}
+
+// RollingOption is the type used to configure rolling, expanding and
+// exponentially weighted windows. Options that don't apply to a kind of window
+// are ignored.
+type RollingOption func(*windowOptions)
+
+// MinPeriods sets the minimum number of non-missing elements a window must have
+// to produce a value. Windows with fewer elements produce NaN. By default, it
+// is the size of the window for rolling windows, and 1 otherwise.
+func MinPeriods(n int) RollingOption {
+	return func(o *windowOptions) {
+		o.minPeriods = n
+	}
+}
+
+// Center sets whether the windows are centered on every element instead of
+// ending on it. For even window sizes, the window has one more element before
+// the center than after it. Only rolling windows can be centered.
+func Center(b bool) RollingOption {
+	return func(o *windowOptions) {
+		o.center = b
+	}
+}
+
 // Rolling creates new RollingWindow
-func (s Series) Rolling(window int) RollingWindow {
-	return RollingWindow{
-		window: window,
-		series: s,
+func (s Series) Rolling(window int, options ...RollingOption) RollingWindow {
+	r := RollingWindow{
+		window:        window,
+		windowOptions: windowOptions{minPeriods: window},
+		series:        s,
 	}
+	for _, option := range options {
+		option(&r.windowOptions)
+	}
+	return r
 }
 
-// Mean returns the rolling mean.
-func (r RollingWindow) Mean() (s Series) {
-	s = New([]float64{}, Float, "Mean")
-	for _, block := range r.getBlocks() {
-		s.Append(block.Mean())
+// Expanding creates a RollingWindow where the window of every element has all
+// the elements up to it.
+func (s Series) Expanding(options ...RollingOption) RollingWindow {
+	r := RollingWindow{
+		window:        s.Len(),
+		windowOptions: windowOptions{minPeriods: 1},
+		series:        s,
 	}
+	for _, option := range options {
+		option(&r.windowOptions)
+	}
+	r.center = false
+	return r
+}
+
+// Mean returns the rolling mean.
+func (r RollingWindow) Mean() Series {
+	return r.incremental("Mean", func(w *windowStats) float64 {
+		return w.mean
+	})
+}
+
+// StdDev returns the rolling sample standard deviation.
+func (r RollingWindow) StdDev() Series {
+	return r.incremental("StdDev", func(w *windowStats) float64 {
+		if w.n < 2 {
+			return math.NaN()
+		}
+		return math.Sqrt(w.m2 / float64(w.n-1))
+	})
+}
+
+// Var returns the rolling sample variance.
+func (r RollingWindow) Var() Series {
+	return r.incremental("Var", func(w *windowStats) float64 {
+		if w.n < 2 {
+			return math.NaN()
+		}
+		return w.m2 / float64(w.n-1)
+	})
+}
+
+// Sum returns the rolling sum.
+func (r RollingWindow) Sum() Series {
+	return r.incremental("Sum", func(w *windowStats) float64 {
+		return w.sum
+	})
+}
+
+// Min returns the rolling minimum.
+func (r RollingWindow) Min() Series {
+	return r.reduce("Min", func(values []float64) float64 {
+		min := values[0]
+		for _, v := range values[1:] {
+			min = math.Min(min, v)
+		}
+		return min
+	})
+}
+
+// Max returns the rolling maximum.
+func (r RollingWindow) Max() Series {
+	return r.reduce("Max", func(values []float64) float64 {
+		max := values[0]
+		for _, v := range values[1:] {
+			max = math.Max(max, v)
+		}
+		return max
+	})
+}
+
+// Median returns the rolling median.
+func (r RollingWindow) Median() Series {
+	return r.reduce("Median", func(values []float64) float64 {
+		if hasNaN(values) {
+			return math.NaN()
+		}
+		sort.Float64s(values)
+		if len(values)%2 != 0 {
+			return values[len(values)/2]
+		}
+		return (values[(len(values)/2)-1] + values[len(values)/2]) * 0.5
+	})
+}
 
-	return
+// Quantile returns the rolling quantile p, like Series.Quantile.
+func (r RollingWindow) Quantile(p float64) Series {
+	return r.reduce("Quantile", func(values []float64) float64 {
+		if hasNaN(values) {
+			return math.NaN()
+		}
+		sort.Float64s(values)
+		return stat.Quantile(p, stat.Empirical, values, nil)
+	})
 }
 
-// StdDev returns the rolling mean.
-func (r RollingWindow) StdDev() (s Series) {
-	s = New([]float64{}, Float, "StdDev")
-	for _, block := range r.getBlocks() {
-		s.Append(block.StdDev())
+// Apply returns the result of calling f with the Series of every window. Only
+// windows with at least the minimum number of non-missing elements are passed
+// to f, including their missing elements.
+func (r RollingWindow) Apply(f func(Series) float64) Series {
+	values := make([]float64, r.series.Len())
+	for i := range values {
+		lo, hi := r.bounds(i)
+		valid := 0
+		for j := lo; j < hi; j++ {
+			if !r.series.elements.Elem(j).IsNull() {
+				valid++
+			}
+		}
+		if hi <= lo || valid < r.minPeriods {
+			values[i] = math.NaN()
+			continue
+		}
+		index := make([]int, hi-lo)
+		for j := range index {
+			index[j] = lo + j
+		}
+		values[i] = f(r.series.Subset(index))
 	}
+	return New(values, Float, "Apply")
+}
 
-	return
+// bounds returns the range [lo, hi) of the window for the element i, clipped to
+// the Series.
+func (r RollingWindow) bounds(i int) (int, int) {
+	lo := i - r.window + 1
+	if r.center {
+		lo = i - r.window/2
+	}
+	hi := lo + r.window
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > r.series.Len() {
+		hi = r.series.Len()
+	}
+	if lo > hi {
+		lo = hi
+	}
+	return lo, hi
 }
 
-func (r RollingWindow) getBlocks() (blocks []Series) {
-	for i := 1; i <= r.series.Len(); i++ {
-		if i < r.window {
-			blocks = append(blocks, r.series.Empty())
+// reduce calls f with the float values of the non-missing elements of every
+// window with at least the minimum number of them. The slice passed to f is
+// reused between calls.
+func (r RollingWindow) reduce(name string, f func(values []float64) float64) Series {
+	ret := make([]float64, r.series.Len())
+	buf := make([]float64, 0, max(r.window, 0))
+	for i := range ret {
+		lo, hi := r.bounds(i)
+		buf = buf[:0]
+		for j := lo; j < hi; j++ {
+			if e := r.series.elements.Elem(j); !e.IsNull() {
+				buf = append(buf, e.Float())
+			}
+		}
+		if len(buf) == 0 || len(buf) < r.minPeriods {
+			ret[i] = math.NaN()
 			continue
 		}
+		ret[i] = f(buf)
+	}
+	return New(ret, Float, name)
+}
 
-		index := []int{}
-		for j := i - r.window; j < i; j++ {
-			index = append(index, j)
+// windowStats holds the running statistics of the non-missing, non-NaN
+// elements of a window, updated with Welford's algorithm.
+type windowStats struct {
+	n    int
+	nans int
+	sum  float64
+	mean float64
+	m2   float64
+}
+
+func (w *windowStats) add(x float64) {
+	if math.IsNaN(x) {
+		w.nans++
+		return
+	}
+	w.n++
+	w.sum += x
+	d := x - w.mean
+	w.mean += d / float64(w.n)
+	w.m2 += d * (x - w.mean)
+}
+
+func (w *windowStats) remove(x float64) {
+	if math.IsNaN(x) {
+		w.nans--
+		return
+	}
+	w.n--
+	if w.n == 0 {
+		*w = windowStats{nans: w.nans}
+		return
+	}
+	w.sum -= x
+	d := x - w.mean
+	w.mean -= d / float64(w.n)
+	w.m2 = math.Max(w.m2-d*(x-w.mean), 0)
+}
+
+// incremental slides the window over the Series updating its statistics in
+// constant time per element, and computes every result with f.
+func (r RollingWindow) incremental(name string, f func(w *windowStats) float64) Series {
+	ret := make([]float64, r.series.Len())
+	var w windowStats
+	update := func(j int, add bool) {
+		e := r.series.elements.Elem(j)
+		if e.IsNull() {
+			return
+		}
+		if add {
+			w.add(e.Float())
+		} else {
+			w.remove(e.Float())
+		}
+	}
+	curLo, curHi := 0, 0
+	for i := range ret {
+		lo, hi := r.bounds(i)
+		for ; curHi < hi; curHi++ {
+			update(curHi, true)
+		}
+		for ; curLo < lo; curLo++ {
+			update(curLo, false)
+		}
+		switch {
+		case w.n+w.nans == 0 || w.n+w.nans < r.minPeriods:
+			ret[i] = math.NaN()
+		case w.nans > 0:
+			ret[i] = math.NaN()
+		default:
+			ret[i] = f(&w)
 		}
-		blocks = append(blocks, r.series.Subset(index))
 	}
+	return New(ret, Float, name)
+}
 
-	return
+// hasNaN reports whether any of the values is NaN.
+func hasNaN(values []float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
 }
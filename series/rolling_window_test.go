@@ -83,3 +83,57 @@ func TestSeries_RollingStdDev(t *testing.T) {
 		}
 	}
 }
+
+func TestSeries_RollingReducers(t *testing.T) {
+	nan := math.NaN()
+	s := Ints([]int{1, 2, 3, 4, 5})
+	tests := []struct {
+		received Series
+		expected Series
+	}{
+		{s.Rolling(3).Sum(), Floats([]float64{nan, nan, 6, 9, 12})},
+		{s.Rolling(3, MinPeriods(1)).Sum(), Floats([]float64{1, 3, 6, 9, 12})},
+		{s.Rolling(3, Center(true)).Sum(), Floats([]float64{nan, 6, 9, 12, nan})},
+		{s.Rolling(3, Center(true), MinPeriods(1)).Sum(), Floats([]float64{3, 6, 9, 12, 9})},
+		{s.Rolling(4, Center(true), MinPeriods(1)).Sum(), Floats([]float64{3, 6, 10, 14, 12})},
+		{s.Rolling(3).Min(), Floats([]float64{nan, nan, 1, 2, 3})},
+		{s.Rolling(3).Max(), Floats([]float64{nan, nan, 3, 4, 5})},
+		{s.Rolling(4).Median(), Floats([]float64{nan, nan, nan, 2.5, 3.5})},
+		{s.Rolling(3).Quantile(0.25), Floats([]float64{nan, nan, 1, 2, 3})},
+		{s.Rolling(2, MinPeriods(1)).Mean(), Floats([]float64{1, 1.5, 2.5, 3.5, 4.5})},
+		{
+			s.Rolling(3, MinPeriods(1)).Apply(func(w Series) float64 { return float64(w.Len()) }),
+			Floats([]float64{1, 2, 3, 3, 3}),
+		},
+		{s.Rolling(0).Sum(), Floats([]float64{nan, nan, nan, nan, nan})},
+		{s.Rolling(-1).Mean(), Floats([]float64{nan, nan, nan, nan, nan})},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.received)
+	}
+}
+
+func TestSeries_RollingMissing(t *testing.T) {
+	nan := math.NaN()
+	nulls := New([]interface{}{1, nil, 3, 4}, Float, "")
+	nans := Floats([]float64{1, nan, 3, 4, 6})
+	tests := []struct {
+		received Series
+		expected Series
+	}{
+		{nulls.Rolling(2).Mean(), Floats([]float64{nan, nan, nan, 3.5})},
+		{nulls.Rolling(2, MinPeriods(1)).Mean(), Floats([]float64{1, 1, 3, 3.5})},
+		{nulls.Rolling(3, MinPeriods(2)).Max(), Floats([]float64{nan, nan, 3, 4})},
+		{
+			nulls.Rolling(2, MinPeriods(1)).Apply(func(w Series) float64 { return float64(w.Len()) }),
+			Floats([]float64{1, 2, 2, 2}),
+		},
+		{nans.Rolling(2).Mean(), Floats([]float64{nan, nan, nan, 3.5, 5})},
+		{nans.Rolling(2).StdDev(), Floats([]float64{nan, nan, nan, 0.7071067811865476, 1.4142135623730951})},
+		{nans.Rolling(2).Min(), Floats([]float64{nan, nan, nan, 3, 4})},
+		{nans.Rolling(2).Median(), Floats([]float64{nan, nan, nan, 3.5, 5})},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.received)
+	}
+}
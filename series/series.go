@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"math"
 
@@ -53,9 +54,11 @@ type Element interface {
 	Int() (int, error)
 	Float() float64
 	Bool() (bool, error)
+	Time() (time.Time, error)
 
 	// Information methods
-	IsNA() bool
+	IsNA() bool   // Missing or, for Float elements, NaN
+	IsNull() bool // Missing
 	Type() Type
 }
 
@@ -83,6 +86,12 @@ type boolElements []boolElement
 func (e boolElements) Len() int           { return len(e) }
 func (e boolElements) Elem(i int) Element { return &e[i] }
 
+// timeElements is the concrete implementation of Elements for Time elements.
+type timeElements []timeElement
+
+func (e timeElements) Len() int           { return len(e) }
+func (e timeElements) Elem(i int) Element { return &e[i] }
+
 // ElementValue represents the value that can be used for marshaling or
 // unmarshaling Elements.
 type ElementValue interface{}
@@ -118,6 +127,14 @@ const (
 	Int    Type = "int"
 	Float  Type = "float"
 	Bool   Type = "bool"
+	Time   Type = "time"
+	// Categorical stores strings as codes on a dictionary of categories.
+	Categorical Type = "categorical"
+	// Numeric types with an explicit width, independent of the platform.
+	Int64   Type = "int64"
+	Int32   Type = "int32"
+	Uint64  Type = "uint64"
+	Float32 Type = "float32"
 )
 
 // Indexes represent the elements that can be used for selecting a subset of
@@ -148,6 +165,12 @@ func New(values interface{}, t Type, name string) Series {
 			ret.elements = make(floatElements, n)
 		case Bool:
 			ret.elements = make(boolElements, n)
+		case Time:
+			ret.elements = make(timeElements, n)
+		case Categorical:
+			ret.elements = newCategoricalElements(n, &categoriesRef{newCategories(nil, false, true)})
+		case Int64, Int32, Uint64, Float32:
+			ret.elements = newSizedElements(t, n)
 		default:
 			panic(fmt.Sprintf("unknown type %v", t))
 		}
@@ -185,6 +208,11 @@ func New(values interface{}, t Type, name string) Series {
 			ret.elements.Elem(i).Set(v[i])
 		}
 	case Series:
+		if t == Categorical && v.t == Categorical {
+			// Keep the categories and their order
+			ret.elements = v.elements.(categoricalElements).clone()
+			return ret
+		}
 		l := v.Len()
 		preAlloc(l)
 		for i := 0; i < l; i++ {
@@ -207,7 +235,6 @@ func New(values interface{}, t Type, name string) Series {
 			ret.elements.Elem(0).Set(val)
 		}
 	}
-
 	return ret
 }
 
@@ -231,8 +258,22 @@ func Bools(values interface{}) Series {
 	return New(values, Bool, "")
 }
 
+// Times is a constructor for a Time Series
+func Times(values interface{}) Series {
+	return New(values, Time, "")
+}
+
 // Empty returns an empty Series of the same type
 func (s Series) Empty() Series {
+	if s.t == Categorical {
+		// Keep the categories and their order
+		ref := s.elements.(categoricalElements).ref
+		return Series{
+			Name:     s.Name,
+			t:        Categorical,
+			elements: newCategoricalElements(0, ref.share()),
+		}
+	}
 	return New([]int{}, s.t, s.Name)
 }
 
@@ -257,6 +298,19 @@ func (s *Series) Append(values interface{}) {
 		s.elements = append(s.elements.(floatElements), news.elements.(floatElements)...)
 	case Bool:
 		s.elements = append(s.elements.(boolElements), news.elements.(boolElements)...)
+	case Time:
+		s.elements = append(s.elements.(timeElements), news.elements.(timeElements)...)
+	case Categorical:
+		// Set the new values on the dictionary of s
+		elements := s.elements.(categoricalElements)
+		for i := 0; i < news.Len(); i++ {
+			e := categoricalElement{-1, elements.ref}
+			e.Set(news.elements.Elem(i))
+			elements.elements = append(elements.elements, e)
+		}
+		s.elements = elements
+	case Int64, Int32, Uint64, Float32:
+		s.elements = s.elements.(sizedOps).concat(news.elements)
 	}
 }
 
@@ -314,6 +368,16 @@ func (s Series) Subset(indexes Indexes) Series {
 			elements[k] = s.elements.(boolElements)[i]
 		}
 		ret.elements = elements
+	case Time:
+		elements := make(timeElements, len(idx))
+		for k, i := range idx {
+			elements[k] = s.elements.(timeElements)[i]
+		}
+		ret.elements = elements
+	case Categorical:
+		ret.elements = s.elements.(categoricalElements).subset(idx)
+	case Int64, Int32, Uint64, Float32:
+		ret.elements = s.elements.(sizedOps).subset(idx)
 	default:
 		panic("unknown series type")
 	}
@@ -368,6 +432,39 @@ func (s Series) IsNaN() []bool {
 	return ret
 }
 
+// HasNull checks whether the Series contain missing elements. Unlike HasNaN,
+// valid NaN values on Float Series are not considered missing.
+func (s Series) HasNull() bool {
+	for i := 0; i < s.Len(); i++ {
+		if s.elements.Elem(i).IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNull returns an array that identifies which of the elements are missing.
+func (s Series) IsNull() []bool {
+	ret := make([]bool, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		ret[i] = s.elements.Elem(i).IsNull()
+	}
+	return ret
+}
+
+// validFloats returns the elements of a Series that are not missing as a
+// []float64.
+func (s Series) validFloats() []float64 {
+	ret := make([]float64, 0, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		if !e.IsNull() {
+			ret = append(ret, e.Float())
+		}
+	}
+	return ret
+}
+
 // Compare compares the values of a Series with other elements. To do so, the
 // elements with are to be compared are first transformed to a Series of the same
 // type as the caller.
@@ -471,6 +568,12 @@ func (s Series) Compare(comparator Comparator, comparando interface{}) Series {
 	return Bools(bools)
 }
 
+// Rename returns the Series with the given name, sharing its elements.
+func (s Series) Rename(name string) Series {
+	s.Name = name
+	return s
+}
+
 // Copy will return a copy of the Series.
 func (s Series) Copy() Series {
 	name := s.Name
@@ -490,6 +593,13 @@ func (s Series) Copy() Series {
 	case Int:
 		elements = make(intElements, s.Len())
 		copy(elements.(intElements), s.elements.(intElements))
+	case Time:
+		elements = make(timeElements, s.Len())
+		copy(elements.(timeElements), s.elements.(timeElements))
+	case Categorical:
+		elements = s.elements.(categoricalElements).clone()
+	case Int64, Int32, Uint64, Float32:
+		elements = s.elements.(sizedOps).clone()
 	}
 	ret := Series{
 		Name:     name,
@@ -552,6 +662,21 @@ func (s Series) Bool() ([]bool, error) {
 	return ret, nil
 }
 
+// Time returns the elements of a Series as a []time.Time or an error if the
+// transformation is not possible.
+func (s Series) Time() ([]time.Time, error) {
+	ret := make([]time.Time, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		val, err := e.Time()
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = val
+	}
+	return ret, nil
+}
+
 // Type returns the type of a given series
 func (s Series) Type() Type {
 	return s.t
@@ -640,15 +765,18 @@ func parseIndexes(l int, indexes Indexes) ([]int, error) {
 }
 
 // Order returns the indexes for sorting a Series. NaN elements are pushed to the
-// end by order of appearance.
+// end by order of appearance, followed by the missing elements.
 func (s Series) Order(reverse bool) []int {
 	var ie indexedElements
-	var nasIdx []int
+	var nasIdx, nullsIdx []int
 	for i := 0; i < s.Len(); i++ {
 		e := s.elements.Elem(i)
-		if e.IsNA() {
+		switch {
+		case e.IsNull():
+			nullsIdx = append(nullsIdx, i)
+		case e.IsNA():
 			nasIdx = append(nasIdx, i)
-		} else {
+		default:
 			ie = append(ie, indexedElement{i, e})
 		}
 	}
@@ -662,7 +790,8 @@ func (s Series) Order(reverse bool) []int {
 	for _, e := range ie {
 		ret = append(ret, e.index)
 	}
-	return append(ret, nasIdx...)
+	ret = append(ret, nasIdx...)
+	return append(ret, nullsIdx...)
 }
 
 type indexedElement struct {
@@ -676,117 +805,136 @@ func (e indexedElements) Len() int           { return len(e) }
 func (e indexedElements) Less(i, j int) bool { return e[i].element.Less(e[j].element) }
 func (e indexedElements) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
 
-// StdDev calculates the standard deviation of a series
+// StdDev calculates the standard deviation of a series. Missing elements are
+// ignored.
 func (s Series) StdDev() float64 {
-	stdDev := stat.StdDev(s.Float(), nil)
+	stdDev := stat.StdDev(s.validFloats(), nil)
 	return stdDev
 }
 
-// Mean calculates the average value of a series
+// Mean calculates the average value of a series. Missing elements are ignored.
 func (s Series) Mean() float64 {
-	stdDev := stat.Mean(s.Float(), nil)
+	stdDev := stat.Mean(s.validFloats(), nil)
 	return stdDev
 }
 
 // Median calculates the middle or median value, as opposed to
 // mean, and there is less susceptible to being affected by outliers.
+// Missing elements are ignored.
 func (s Series) Median() float64 {
 	if s.elements.Len() == 0 ||
 		s.Type() == String ||
+		s.Type() == Categorical ||
 		s.Type() == Bool {
 		return math.NaN()
 	}
-	ix := s.Order(false)
-	newElem := make([]Element, len(ix))
-
-	for newpos, oldpos := range ix {
-		newElem[newpos] = s.elements.Elem(oldpos)
+	values := s.validFloats()
+	if len(values) == 0 {
+		return math.NaN()
 	}
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return math.NaN()
+		}
+	}
+	sort.Float64s(values)
 
 	// When length is odd, we just take length(list)/2
 	// value as the median.
-	if len(newElem)%2 != 0 {
-		return newElem[len(newElem)/2].Float()
+	if len(values)%2 != 0 {
+		return values[len(values)/2]
 	}
 	// When length is even, we take middle two elements of
 	// list and the median is an average of the two of them.
-	return (newElem[(len(newElem)/2)-1].Float() +
-		newElem[len(newElem)/2].Float()) * 0.5
+	return (values[(len(values)/2)-1] +
+		values[len(values)/2]) * 0.5
 }
 
-// Max return the biggest element in the series
+// Max return the biggest element in the series. Missing elements are ignored.
 func (s Series) Max() float64 {
-	if s.elements.Len() == 0 || s.Type() == String {
+	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Categorical {
 		return math.NaN()
 	}
 
-	max := s.elements.Elem(0)
-	for i := 1; i < s.elements.Len(); i++ {
-		elem := s.elements.Elem(i)
-		if elem.Greater(max) {
-			max = elem
-		}
+	max := s.extreme(Element.Greater)
+	if max == nil {
+		return math.NaN()
 	}
 	return max.Float()
 }
 
-// MaxStr return the biggest element in a series of type String
+// MaxStr return the biggest element in a series of type String, or of
+// type Categorical following the order of its categories. Missing elements
+// are ignored.
 func (s Series) MaxStr() string {
-	if s.elements.Len() == 0 || s.Type() != String {
+	if s.elements.Len() == 0 || (s.Type() != String && s.Type() != Categorical) {
 		return ""
 	}
 
-	max := s.elements.Elem(0)
-	for i := 1; i < s.elements.Len(); i++ {
-		elem := s.elements.Elem(i)
-		if elem.Greater(max) {
-			max = elem
-		}
+	max := s.extreme(Element.Greater)
+	if max == nil {
+		return ""
 	}
 	return max.String()
 }
 
-// Min return the lowest element in the series
+// Min return the lowest element in the series. Missing elements are ignored.
 func (s Series) Min() float64 {
-	if s.elements.Len() == 0 || s.Type() == String {
+	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Categorical {
 		return math.NaN()
 	}
 
-	min := s.elements.Elem(0)
-	for i := 1; i < s.elements.Len(); i++ {
-		elem := s.elements.Elem(i)
-		if elem.Less(min) {
-			min = elem
-		}
+	min := s.extreme(Element.Less)
+	if min == nil {
+		return math.NaN()
 	}
 	return min.Float()
 }
 
-// MinStr return the lowest element in a series of type String
+// MinStr return the lowest element in a series of type String, or of
+// type Categorical following the order of its categories. Missing elements
+// are ignored.
 func (s Series) MinStr() string {
-	if s.elements.Len() == 0 || s.Type() != String {
+	if s.elements.Len() == 0 || (s.Type() != String && s.Type() != Categorical) {
+		return ""
+	}
+
+	min := s.extreme(Element.Less)
+	if min == nil {
 		return ""
 	}
+	return min.String()
+}
 
-	min := s.elements.Elem(0)
-	for i := 1; i < s.elements.Len(); i++ {
+// extreme returns the first element that is not missing and for which better
+// holds against every other element, or nil if all the elements are missing.
+func (s Series) extreme(better func(a, b Element) bool) Element {
+	var ret Element
+	for i := 0; i < s.elements.Len(); i++ {
 		elem := s.elements.Elem(i)
-		if elem.Less(min) {
-			min = elem
+		if elem.IsNull() {
+			continue
+		}
+		if ret == nil || better(elem, ret) {
+			ret = elem
 		}
 	}
-	return min.String()
+	return ret
 }
 
 // Quantile returns the sample of x such that x is greater than or
-// equal to the fraction p of samples.
+// equal to the fraction p of samples. Missing elements are ignored.
 // Note: gonum/stat panics when called with strings
 func (s Series) Quantile(p float64) float64 {
-	if s.Type() == String || s.Len() == 0 {
+	if s.Type() == String || s.Type() == Categorical || s.Len() == 0 {
 		return math.NaN()
 	}
 
-	ordered := s.Subset(s.Order(false)).Float()
+	ordered := s.validFloats()
+	if len(ordered) == 0 {
+		return math.NaN()
+	}
+	sort.Float64s(ordered)
 
 	return stat.Quantile(p, stat.Empirical, ordered, nil)
 }
@@ -807,12 +955,15 @@ func (s Series) Map(f MapFunction) Series {
 	return New(mappedValues, s.Type(), s.Name)
 }
 
-// Sum calculates the sum value of a series
+// Sum calculates the sum value of a series. Missing elements are ignored.
 func (s Series) Sum() float64 {
-	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Bool {
+	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Categorical || s.Type() == Bool || s.Type() == Time {
+		return math.NaN()
+	}
+	sFloat := s.validFloats()
+	if len(sFloat) == 0 {
 		return math.NaN()
 	}
-	sFloat := s.Float()
 	sum := sFloat[0]
 	for i := 1; i < len(sFloat); i++ {
 		elem := sFloat[i]
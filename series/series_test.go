@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Check that there are no shared memory addreses between the elements of two Series
@@ -855,7 +856,7 @@ func TestFloats(t *testing.T) {
 		},
 		{
 			Floats(math.NaN()),
-			"[NaN]",
+			"[nan]",
 		},
 		{
 			Floats(math.Inf(1)),
@@ -1004,6 +1005,105 @@ func TestBools(t *testing.T) {
 	}
 }
 
+func TestTimes(t *testing.T) {
+	date := time.Date(2021, 10, 10, 12, 30, 0, 0, time.UTC)
+	table := []struct {
+		series   Series
+		expected string
+	}{
+		{
+			Times([]string{"A", "2021-10-10T12:30:00Z", "2021-10-10", "NaN"}),
+			"[NaN 2021-10-10T12:30:00Z 2021-10-10T00:00:00Z NaN]",
+		},
+		{
+			Times("2021-10-10 12:30:00.5"),
+			"[2021-10-10T12:30:00.5Z]",
+		},
+		{
+			Times([]time.Time{date}),
+			"[2021-10-10T12:30:00Z]",
+		},
+		{
+			Times(date),
+			"[2021-10-10T12:30:00Z]",
+		},
+		{
+			Times([]int{0, 1633869000}),
+			"[1970-01-01T00:00:00Z 2021-10-10T12:30:00Z]",
+		},
+		{
+			Times([]float64{1633869000.25, math.NaN()}),
+			"[2021-10-10T12:30:00.25Z NaN]",
+		},
+		{
+			Times([]bool{true}),
+			"[NaN]",
+		},
+		{
+			Times([]int{}),
+			"[]",
+		},
+		{
+			Times(nil),
+			"[NaN]",
+		},
+		{
+			Times(Strings([]string{"2021-10-10T12:30:00Z"})),
+			"[2021-10-10T12:30:00Z]",
+		},
+	}
+	for testnum, test := range table {
+		if err := test.series.Err; err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		expected := test.expected
+		received := fmt.Sprint(test.series)
+		if expected != received {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+	}
+}
+
+func TestSeries_Compare_Time(t *testing.T) {
+	s := Times([]string{"2021-01-01", "2021-06-01", "NaN", "2022-01-01"})
+	table := []struct {
+		comparator Comparator
+		comparando interface{}
+		expected   []bool
+	}{
+		{Eq, "2021-06-01", []bool{false, true, false, false}},
+		{Neq, "2021-06-01", []bool{true, false, false, true}},
+		{Less, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), []bool{true, false, false, false}},
+		{LessEq, "2021-06-01T00:00:00Z", []bool{true, true, false, false}},
+		{Greater, "2021-06-01", []bool{false, false, false, true}},
+		{GreaterEq, "2021-06-01", []bool{false, true, false, true}},
+		{In, []string{"2021-01-01", "2022-01-01"}, []bool{true, false, false, true}},
+	}
+	for testnum, test := range table {
+		received, err := s.Compare(test.comparator, test.comparando).Bool()
+		if err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	order := s.Order(true)
+	if expected := []int{3, 1, 0, 2}; !reflect.DeepEqual(expected, order) {
+		t.Errorf("Order:\nExpected:\n%v\nReceived:\n%v", expected, order)
+	}
+}
+
 func TestSeries_Copy(t *testing.T) {
 	tests := []Series{
 		Strings([]string{"1", "2", "3", "a", "b", "c"}),
@@ -1249,6 +1349,102 @@ func TestSeries_IsNaN(t *testing.T) {
 	}
 }
 
+func TestSeries_IsNull(t *testing.T) {
+	table := []struct {
+		series   Series
+		nulls    []bool
+		nas      []bool
+		expected string
+	}{
+		{
+			Floats([]interface{}{1.0, math.NaN(), nil, "NaN"}),
+			[]bool{false, false, true, true},
+			[]bool{false, true, true, true},
+			"[1.000000 nan NaN NaN]",
+		},
+		{
+			Ints([]interface{}{1, nil, "a"}),
+			[]bool{false, true, true},
+			[]bool{false, true, true},
+			"[1 NaN NaN]",
+		},
+		{
+			Strings(Ints([]interface{}{1, nil})),
+			[]bool{false, true},
+			[]bool{false, true},
+			"[1 NaN]",
+		},
+		{
+			Floats(Ints([]interface{}{1, nil})).Copy(),
+			[]bool{false, true},
+			[]bool{false, true},
+			"[1.000000 NaN]",
+		},
+		{
+			Floats([]float64{math.NaN()}).Copy(),
+			[]bool{false},
+			[]bool{true},
+			"[nan]",
+		},
+	}
+	for testnum, test := range table {
+		if received := test.series.IsNull(); !reflect.DeepEqual(test.nulls, received) {
+			t.Errorf("Test:%v\nExpected nulls:\n%v\nReceived:\n%v", testnum, test.nulls, received)
+		}
+		if received := test.series.IsNaN(); !reflect.DeepEqual(test.nas, received) {
+			t.Errorf("Test:%v\nExpected NaNs:\n%v\nReceived:\n%v", testnum, test.nas, received)
+		}
+		if received := fmt.Sprint(test.series); test.expected != received {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, received)
+		}
+	}
+
+	if v, ok := Floats(math.NaN()).Val(0).(float64); !ok || !math.IsNaN(v) {
+		t.Errorf("Expected NaN value, got %v", v)
+	}
+	if v := Floats([]interface{}{nil}).Val(0); v != nil {
+		t.Errorf("Expected nil value, got %v", v)
+	}
+}
+
+func TestSeries_Nulls_Aggregations(t *testing.T) {
+	s := Floats([]interface{}{4.0, nil, 1.0, 3.0, nil})
+	table := []struct {
+		name     string
+		received float64
+		expected float64
+	}{
+		{"Mean", s.Mean(), 8.0 / 3},
+		{"Sum", s.Sum(), 8},
+		{"Median", s.Median(), 3},
+		{"Max", s.Max(), 4},
+		{"Min", s.Min(), 1},
+		{"StdDev", s.StdDev(), 1.5275252316519468},
+		{"Quantile", s.Quantile(0.5), 3},
+		{"Sum all nulls", Ints([]interface{}{nil, nil}).Sum(), math.NaN()},
+		{"Max all nulls", Ints([]interface{}{nil, nil}).Max(), math.NaN()},
+		{"Mean NaN value", Floats([]interface{}{1.0, math.NaN(), nil}).Mean(), math.NaN()},
+	}
+	for _, test := range table {
+		if !compareFloats(test.expected, test.received, 6) {
+			t.Errorf("%v\nExpected:\n%v\nReceived:\n%v", test.name, test.expected, test.received)
+		}
+	}
+
+	order := Floats([]interface{}{nil, 2.0, math.NaN(), 1.0}).Order(false)
+	if expected := []int{3, 1, 2, 0}; !reflect.DeepEqual(expected, order) {
+		t.Errorf("Order\nExpected:\n%v\nReceived:\n%v", expected, order)
+	}
+	if max := Strings([]interface{}{nil, "b", "a"}).MaxStr(); max != "b" {
+		t.Errorf("MaxStr\nExpected:\n%v\nReceived:\n%v", "b", max)
+	}
+
+	compared, _ := Floats([]interface{}{nil, 2.0, math.NaN()}).Compare(Neq, 1.0).Bool()
+	if expected := []bool{false, true, false}; !reflect.DeepEqual(expected, compared) {
+		t.Errorf("Compare\nExpected:\n%v\nReceived:\n%v", expected, compared)
+	}
+}
+
 func TestSeries_StdDev(t *testing.T) {
 	tests := []struct {
 		series   Series
@@ -1654,8 +1850,8 @@ func TestSeries_Map(t *testing.T) {
 		i, err := result.Int()
 		if err != nil {
 			return Element(&intElement{
-				e:   +5,
-				nan: false,
+				e:    +5,
+				null: false,
 			})
 		}
 		result.Set(i + 5)
@@ -4,22 +4,23 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 )
 
 type boolElement struct {
-	e   bool
-	nan bool
+	e    bool
+	null bool
 }
 
 // force boolElement struct to implement Element interface
 var _ Element = (*boolElement)(nil)
 
 func (e *boolElement) Set(value interface{}) {
-	e.nan = false
+	e.null = false
 	switch val := value.(type) {
 	case string:
 		if val == "NaN" {
-			e.nan = true
+			e.null = true
 			return
 		}
 		switch strings.ToLower(value.(string)) {
@@ -28,7 +29,7 @@ func (e *boolElement) Set(value interface{}) {
 		case "false", "f", "0":
 			e.e = false
 		default:
-			e.nan = true
+			e.null = true
 			return
 		}
 	case int:
@@ -38,7 +39,7 @@ func (e *boolElement) Set(value interface{}) {
 		case 0:
 			e.e = false
 		default:
-			e.nan = true
+			e.null = true
 			return
 		}
 	case float64:
@@ -48,20 +49,24 @@ func (e *boolElement) Set(value interface{}) {
 		case 0:
 			e.e = false
 		default:
-			e.nan = true
+			e.null = true
 			return
 		}
 	case bool:
 		e.e = val
 	case Element:
+		if val.IsNull() {
+			e.null = true
+			return
+		}
 		b, err := value.(Element).Bool()
 		if err != nil {
-			e.nan = true
+			e.null = true
 			return
 		}
 		e.e = b
 	default:
-		e.nan = true
+		e.null = true
 		return
 	}
 }
@@ -74,7 +79,11 @@ func (e boolElement) Copy() Element {
 }
 
 func (e boolElement) IsNA() bool {
-	return e.nan
+	return e.null
+}
+
+func (e boolElement) IsNull() bool {
+	return e.null
 }
 
 func (e boolElement) Type() Type {
@@ -125,6 +134,10 @@ func (e boolElement) Bool() (bool, error) {
 	return bool(e.e), nil
 }
 
+func (e boolElement) Time() (time.Time, error) {
+	return time.Time{}, fmt.Errorf("can't convert Bool to time")
+}
+
 func (e boolElement) Eq(elem Element) bool {
 	b, err := elem.Bool()
 	if err != nil || e.IsNA() {
@@ -0,0 +1,350 @@
+package series
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// categories is the dictionary of a Categorical Series. The codes of the
+// categories are only appended, so that they stay valid for the elements, while
+// their order is lexical for the Series created with New, the given one for
+// NewCategorical, and with new categories added last otherwise.
+//
+// Dictionaries are shared by the Series created with Copy and Subset, and are
+// cloned before adding categories to a shared one, so that every Series sees
+// only its own categories.
+type categories struct {
+	values []string
+	codes  map[string]int
+	// If fixed, values not in the dictionary are set as missing instead of
+	// being added to it.
+	fixed bool
+	// If sorted, the categories are in lexical order instead of the order in
+	// which they were added.
+	sorted bool
+	// Position of every code in the order of the categories, computed when
+	// first needed after adding categories.
+	rank atomic.Pointer[[]int]
+	// Set once the dictionary is used by several Series.
+	shared atomic.Bool
+}
+
+func newCategories(values []string, fixed, sorted bool) *categories {
+	c := &categories{codes: make(map[string]int, len(values)), fixed: fixed, sorted: sorted}
+	for _, v := range values {
+		if _, ok := c.codes[v]; !ok {
+			c.add(v)
+		}
+	}
+	return c
+}
+
+// add adds v to the dictionary and returns its code.
+func (c *categories) add(v string) int {
+	c.codes[v] = len(c.values)
+	c.values = append(c.values, v)
+	return c.codes[v]
+}
+
+// ranks returns the position of every code in the order of the categories.
+func (c *categories) ranks() []int {
+	if r := c.rank.Load(); r != nil && len(*r) == len(c.values) {
+		return *r
+	}
+	rank := make([]int, len(c.values))
+	for code := range rank {
+		rank[code] = code
+	}
+	if c.sorted {
+		order := append([]int(nil), rank...)
+		sort.Slice(order, func(i, j int) bool { return c.values[order[i]] < c.values[order[j]] })
+		for pos, code := range order {
+			rank[code] = pos
+		}
+	}
+	c.rank.Store(&rank)
+	return rank
+}
+
+// ordered returns the categories in order.
+func (c *categories) ordered() []string {
+	values := make([]string, len(c.values))
+	for code, pos := range c.ranks() {
+		values[pos] = c.values[code]
+	}
+	return values
+}
+
+func (c *categories) clone() *categories {
+	codes := make(map[string]int, len(c.codes))
+	for v, code := range c.codes {
+		codes[v] = code
+	}
+	return &categories{
+		values: append([]string(nil), c.values...),
+		codes:  codes,
+		fixed:  c.fixed,
+		sorted: c.sorted,
+	}
+}
+
+// categoriesRef is the dictionary used by the elements of a Series, which is
+// replaced with a clone before adding categories to a shared dictionary.
+type categoriesRef struct {
+	c *categories
+}
+
+// code returns the code of v, adding it to the dictionary unless it is fixed,
+// or -1 if it is not a category.
+func (r *categoriesRef) code(v string) int {
+	if code, ok := r.c.codes[v]; ok {
+		return code
+	}
+	if r.c.fixed {
+		return -1
+	}
+	if r.c.shared.Load() {
+		r.c = r.c.clone()
+	}
+	return r.c.add(v)
+}
+
+// share returns a new reference to the dictionary, for another Series.
+func (r *categoriesRef) share() *categoriesRef {
+	r.c.shared.Store(true)
+	return &categoriesRef{r.c}
+}
+
+type categoricalElement struct {
+	code int // -1 if missing
+	ref  *categoriesRef
+}
+
+// force categoricalElement struct to implement Element interface
+var _ Element = (*categoricalElement)(nil)
+
+func (e *categoricalElement) Set(value interface{}) {
+	e.code = -1
+	switch val := value.(type) {
+	case string:
+		if val == "NaN" {
+			return
+		}
+		e.code = e.ref.code(val)
+	case int:
+		e.code = e.ref.code(strconv.Itoa(val))
+	case float64:
+		e.code = e.ref.code(strconv.FormatFloat(val, 'f', 6, 64))
+	case bool:
+		e.code = e.ref.code(strconv.FormatBool(val))
+	case *categoricalElement:
+		if val.IsNull() {
+			return
+		}
+		if val.ref.c == e.ref.c {
+			e.code = val.code
+			return
+		}
+		e.code = e.ref.code(val.String())
+	case Element:
+		if val.IsNull() {
+			return
+		}
+		e.code = e.ref.code(val.String())
+	}
+}
+
+func (e categoricalElement) Copy() Element {
+	return &categoricalElement{e.code, e.ref.share()}
+}
+
+func (e categoricalElement) IsNA() bool {
+	return e.code < 0
+}
+
+func (e categoricalElement) IsNull() bool {
+	return e.code < 0
+}
+
+func (e categoricalElement) Type() Type {
+	return Categorical
+}
+
+func (e categoricalElement) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.ref.c.values[e.code]
+}
+
+func (e categoricalElement) String() string {
+	if e.IsNA() {
+		return "NaN"
+	}
+	return e.ref.c.values[e.code]
+}
+
+// str returns the element as a String element, for conversions.
+func (e categoricalElement) str() stringElement {
+	if e.IsNA() {
+		return stringElement{"", true}
+	}
+	return stringElement{e.ref.c.values[e.code], false}
+}
+
+func (e categoricalElement) Int() (int, error) {
+	return e.str().Int()
+}
+
+func (e categoricalElement) Float() float64 {
+	return e.str().Float()
+}
+
+func (e categoricalElement) Bool() (bool, error) {
+	return e.str().Bool()
+}
+
+func (e categoricalElement) Time() (time.Time, error) {
+	return e.str().Time()
+}
+
+// compare returns the difference between the positions of the categories of e
+// and elem, which can be any element whose value is one of the categories of e.
+func (e categoricalElement) compare(elem Element) (int, bool) {
+	if e.IsNA() || elem.IsNA() {
+		return 0, false
+	}
+	dict := e.ref.c
+	if o, ok := elem.(*categoricalElement); ok && o.ref.c == dict {
+		rank := dict.ranks()
+		return rank[e.code] - rank[o.code], true
+	}
+	code, ok := dict.codes[elem.String()]
+	if !ok {
+		return 0, false
+	}
+	rank := dict.ranks()
+	return rank[e.code] - rank[code], true
+}
+
+func (e categoricalElement) Eq(elem Element) bool {
+	if o, ok := elem.(*categoricalElement); ok && o.ref.c == e.ref.c {
+		return !e.IsNA() && !o.IsNA() && e.code == o.code
+	}
+	if e.IsNA() || elem.IsNA() {
+		return false
+	}
+	return e.ref.c.values[e.code] == elem.String()
+}
+
+func (e categoricalElement) Neq(elem Element) bool {
+	if e.IsNA() || elem.IsNA() {
+		return false
+	}
+	return !e.Eq(elem)
+}
+
+func (e categoricalElement) Less(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c < 0
+}
+
+func (e categoricalElement) LessEq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c <= 0
+}
+
+func (e categoricalElement) Greater(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c > 0
+}
+
+func (e categoricalElement) GreaterEq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c >= 0
+}
+
+// categoricalElements is the concrete implementation of Elements for
+// Categorical elements.
+type categoricalElements struct {
+	elements []categoricalElement
+	ref      *categoriesRef
+}
+
+func (e categoricalElements) Len() int           { return len(e.elements) }
+func (e categoricalElements) Elem(i int) Element { return &e.elements[i] }
+
+func (e categoricalElements) String() string {
+	return fmt.Sprint(e.elements)
+}
+
+// subset returns the elements at the given positions, sharing the dictionary.
+func (e categoricalElements) subset(idx []int) categoricalElements {
+	ret := newCategoricalElements(len(idx), e.ref.share())
+	for k, i := range idx {
+		ret.elements[k].code = e.elements[i].code
+	}
+	return ret
+}
+
+// clone returns a copy of the elements, sharing the dictionary.
+func (e categoricalElements) clone() categoricalElements {
+	ret := newCategoricalElements(len(e.elements), e.ref.share())
+	for i, el := range e.elements {
+		ret.elements[i].code = el.code
+	}
+	return ret
+}
+
+func newCategoricalElements(n int, ref *categoriesRef) categoricalElements {
+	elements := make([]categoricalElement, n)
+	for i := range elements {
+		elements[i] = categoricalElement{-1, ref}
+	}
+	return categoricalElements{elements, ref}
+}
+
+// NewCategorical creates a Categorical Series with the given categories, in
+// order, which define the order of its elements. Values that are not one of the
+// categories are stored as missing, also when set later on.
+func NewCategorical(values interface{}, categories []string, name string) Series {
+	ret := Series{
+		Name:     name,
+		t:        Categorical,
+		elements: newCategoricalElements(0, &categoriesRef{newCategories(categories, true, false)}),
+	}
+	ret.Append(values)
+	return ret
+}
+
+// Categories returns the categories of a Categorical Series in order, or nil
+// for other types. They may include values that are not present in the Series.
+func (s Series) Categories() []string {
+	elements, ok := s.elements.(categoricalElements)
+	if !ok {
+		return nil
+	}
+	return elements.ref.c.ordered()
+}
+
+// Codes returns the position in Categories of the value of every element of a
+// Categorical Series, or -1 for missing elements. It returns nil for other
+// types.
+func (s Series) Codes() []int {
+	elements, ok := s.elements.(categoricalElements)
+	if !ok {
+		return nil
+	}
+	rank := elements.ref.c.ranks()
+	codes := make([]int, len(elements.elements))
+	for i, e := range elements.elements {
+		codes[i] = -1
+		if e.code >= 0 {
+			codes[i] = rank[e.code]
+		}
+	}
+	return codes
+}
@@ -0,0 +1,241 @@
+package series
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestCategorical_New(t *testing.T) {
+	table := []struct {
+		series     Series
+		records    []string
+		categories []string
+		codes      []int
+	}{
+		{
+			New([]string{"b", "a", "NaN", "c", "a"}, Categorical, "A"),
+			[]string{"b", "a", "NaN", "c", "a"},
+			[]string{"a", "b", "c"},
+			[]int{1, 0, -1, 2, 0},
+		},
+		{
+			New([]interface{}{1, nil, 2.5, true}, Categorical, "A"),
+			[]string{"1", "NaN", "2.500000", "true"},
+			[]string{"1", "2.500000", "true"},
+			[]int{0, -1, 1, 2},
+		},
+		{
+			NewCategorical([]string{"low", "high", "mid", "other", "NaN"}, []string{"low", "mid", "high"}, "A"),
+			[]string{"low", "high", "mid", "NaN", "NaN"},
+			[]string{"low", "mid", "high"},
+			[]int{0, 2, 1, -1, -1},
+		},
+		{
+			New(Strings([]string{"y", "x", "y"}), Categorical, "A"),
+			[]string{"y", "x", "y"},
+			[]string{"x", "y"},
+			[]int{1, 0, 1},
+		},
+		{
+			New(NewCategorical([]string{"b", "c"}, []string{"c", "b", "a"}, "A"), Categorical, "A"),
+			[]string{"b", "c"},
+			[]string{"c", "b", "a"},
+			[]int{1, 0},
+		},
+	}
+	for testnum, test := range table {
+		if err := test.series.Err; err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if got := test.series.Type(); got != Categorical {
+			t.Errorf("Test:%v\nExpected type %v, got %v", testnum, Categorical, got)
+		}
+		if got := test.series.Records(); !reflect.DeepEqual(test.records, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.records, got)
+		}
+		if got := test.series.Categories(); !reflect.DeepEqual(test.categories, got) {
+			t.Errorf("Test:%v\nExpected categories:\n%v\nReceived:\n%v", testnum, test.categories, got)
+		}
+		if got := test.series.Codes(); !reflect.DeepEqual(test.codes, got) {
+			t.Errorf("Test:%v\nExpected codes:\n%v\nReceived:\n%v", testnum, test.codes, got)
+		}
+	}
+
+	if Strings([]string{"a"}).Categories() != nil || Ints([]int{1}).Codes() != nil {
+		t.Errorf("Expected nil categories and codes for other types")
+	}
+}
+
+func TestCategorical_Order(t *testing.T) {
+	s := NewCategorical([]string{"mid", "NaN", "low", "high", "low"}, []string{"low", "mid", "high"}, "A")
+	table := []struct {
+		reverse  bool
+		expected []int
+	}{
+		{false, []int{2, 4, 0, 3, 1}},
+		{true, []int{3, 0, 2, 4, 1}},
+	}
+	for testnum, test := range table {
+		if got := s.Order(test.reverse); !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got)
+		}
+	}
+	if got, expected := s.MinStr(), "low"; got != expected {
+		t.Errorf("Expected min %v, got %v", expected, got)
+	}
+	if got, expected := s.MaxStr(), "high"; got != expected {
+		t.Errorf("Expected max %v, got %v", expected, got)
+	}
+}
+
+func TestCategorical_Compare(t *testing.T) {
+	s := NewCategorical([]string{"mid", "NaN", "low", "high"}, []string{"low", "mid", "high"}, "A")
+	table := []struct {
+		comparator Comparator
+		comparando interface{}
+		expected   []bool
+	}{
+		{Eq, "mid", []bool{true, false, false, false}},
+		{Neq, "mid", []bool{false, false, true, true}},
+		{Less, "mid", []bool{false, false, true, false}},
+		{LessEq, "mid", []bool{true, false, true, false}},
+		{Greater, "mid", []bool{false, false, false, true}},
+		{GreaterEq, "mid", []bool{true, false, false, true}},
+		{Less, "other", []bool{false, false, false, false}},
+		{In, []string{"low", "high"}, []bool{false, false, true, true}},
+		{Eq, s, []bool{true, false, true, true}},
+		{Eq, Strings([]string{"mid", "low", "low", "low"}), []bool{true, false, true, false}},
+	}
+	for testnum, test := range table {
+		b := s.Compare(test.comparator, test.comparando)
+		if b.Err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, b.Err)
+			continue
+		}
+		got, _ := b.Bool()
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got)
+		}
+	}
+}
+
+func TestCategorical_Conversion(t *testing.T) {
+	s := NewCategorical([]string{"2", "NaN", "10"}, []string{"10", "2"}, "A")
+	table := []struct {
+		t        Type
+		expected []string
+	}{
+		{String, []string{"2", "NaN", "10"}},
+		{Int, []string{"2", "NaN", "10"}},
+		{Float, []string{"2.000000", "NaN", "10.000000"}},
+	}
+	for testnum, test := range table {
+		got := New(s, test.t, "A")
+		if got.Type() != test.t {
+			t.Errorf("Test:%v\nExpected type %v, got %v", testnum, test.t, got.Type())
+		}
+		if !reflect.DeepEqual(test.expected, got.Records()) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got.Records())
+		}
+		if !got.Elem(1).IsNull() {
+			t.Errorf("Test:%v\nExpected missing element", testnum)
+		}
+	}
+	back := New(New(s, String, "A"), Categorical, "A")
+	if expected := []string{"10", "2"}; !reflect.DeepEqual(expected, back.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", expected, back.Categories())
+	}
+}
+
+func TestCategorical_Append(t *testing.T) {
+	s := New([]string{"b", "a"}, Categorical, "A")
+	s.Append([]string{"c", "a", "NaN"})
+	s.Append(Strings([]string{"b"}))
+	if expected := []string{"b", "a", "c", "a", "NaN", "b"}; !reflect.DeepEqual(expected, s.Records()) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, s.Records())
+	}
+	if expected := []int{1, 0, 2, 0, -1, 1}; !reflect.DeepEqual(expected, s.Codes()) {
+		t.Errorf("Expected codes:\n%v\nReceived:\n%v", expected, s.Codes())
+	}
+
+	// New categories are kept in lexical order
+	m := New([]string{"m", "z"}, Categorical, "A")
+	m.Append([]string{"a"})
+	if expected := []string{"a", "m", "z"}; !reflect.DeepEqual(expected, m.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", expected, m.Categories())
+	}
+	if expected := []int{1, 2, 0}; !reflect.DeepEqual(expected, m.Codes()) {
+		t.Errorf("Expected codes:\n%v\nReceived:\n%v", expected, m.Codes())
+	}
+	if min, order := m.MinStr(), m.Order(false); min != "a" || !reflect.DeepEqual([]int{2, 0, 1}, order) {
+		t.Errorf("Expected min a and order [2 0 1], got %s and %v", min, order)
+	}
+
+	fixed := NewCategorical([]string{"x"}, []string{"x", "y"}, "A")
+	fixed.Append([]string{"y", "z"})
+	if expected := []string{"x", "y", "NaN"}; !reflect.DeepEqual(expected, fixed.Records()) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, fixed.Records())
+	}
+}
+
+func TestCategorical_SubsetCopy(t *testing.T) {
+	s := NewCategorical([]string{"a", "b", "c", "b"}, []string{"c", "b", "a"}, "A")
+	sub := s.Subset([]int{3, 1})
+	if expected := []string{"b", "b"}; !reflect.DeepEqual(expected, sub.Records()) {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, sub.Records())
+	}
+	if !reflect.DeepEqual(s.Categories(), sub.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", s.Categories(), sub.Categories())
+	}
+	if !sub.Elem(0).Eq(s.Elem(1)) || !sub.Elem(0).Less(s.Elem(0)) {
+		t.Errorf("Expected elements to compare by category")
+	}
+
+	c := s.Copy()
+	c.Elem(0).Set("c")
+	if s.Elem(0).String() != "a" || c.Elem(0).String() != "c" {
+		t.Errorf("Expected copy to be independent, got %v and %v", s.Records(), c.Records())
+	}
+
+	// New categories of copies and subsets aren't added to the original
+	n := New([]string{"a", "c"}, Categorical, "A")
+	for _, d := range []Series{n.Copy(), n.Subset([]int{0})} {
+		d.Elem(0).Set("b")
+		d.Append("d")
+	}
+	if expected := []string{"a", "c"}; !reflect.DeepEqual(expected, n.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", expected, n.Categories())
+	}
+
+	// Nor the other way around, also when set from several goroutines
+	copies := []Series{n.Copy(), n.Subset([]int{1, 0})}
+	n.Append("e")
+	var wg sync.WaitGroup
+	for _, d := range copies {
+		wg.Add(1)
+		go func(d Series) {
+			defer wg.Done()
+			d.Elem(0).Set("z")
+		}(d)
+	}
+	wg.Wait()
+	for i, d := range copies {
+		if expected := []string{"a", "c", "z"}; !reflect.DeepEqual(expected, d.Categories()) {
+			t.Errorf("Test: %d\nExpected categories:\n%v\nReceived:\n%v", i, expected, d.Categories())
+		}
+	}
+	if expected := []string{"a", "c", "e"}; !reflect.DeepEqual(expected, n.Categories()) {
+		t.Errorf("Expected categories:\n%v\nReceived:\n%v", expected, n.Categories())
+	}
+}
+
+func TestCategorical_String(t *testing.T) {
+	s := New([]string{"b", "NaN", "a"}, Categorical, "A")
+	if expected := "[b NaN a]"; s.String() != expected {
+		t.Errorf("Expected:\n%v\nReceived:\n%v", expected, s.String())
+	}
+	if expected := Strings([]string{"b", "NaN", "a"}).String(); s.String() != expected {
+		t.Errorf("Expected same output as String Series:\n%v\nReceived:\n%v", expected, s.String())
+	}
+}
@@ -4,27 +4,28 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"time"
 )
 
 type floatElement struct {
-	e   float64
-	nan bool
+	e    float64
+	null bool
 }
 
 // force floatElement struct to implement Element interface
 var _ Element = (*floatElement)(nil)
 
 func (e *floatElement) Set(value interface{}) {
-	e.nan = false
+	e.null = false
 	switch val := value.(type) {
 	case string:
 		if val == "NaN" {
-			e.nan = true
+			e.null = true
 			return
 		}
 		f, err := strconv.ParseFloat(value.(string), 64)
 		if err != nil {
-			e.nan = true
+			e.null = true
 			return
 		}
 		e.e = f
@@ -32,6 +33,8 @@ func (e *floatElement) Set(value interface{}) {
 		e.e = float64(val)
 	case float64:
 		e.e = float64(val)
+	case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32:
+		e.e, _ = toFloat64(val)
 	case bool:
 		b := val
 		if b {
@@ -40,42 +43,59 @@ func (e *floatElement) Set(value interface{}) {
 			e.e = 0
 		}
 	case Element:
+		if val.IsNull() {
+			e.null = true
+			return
+		}
 		e.e = val.Float()
 	default:
-		e.nan = true
+		e.null = true
 		return
 	}
 }
 
 func (e floatElement) Copy() Element {
-	if e.IsNA() {
+	if e.IsNull() {
 		return &floatElement{0.0, true}
 	}
 	return &floatElement{e.e, false}
 }
 
+// IsNA returns true for missing elements but also for valid NaN values.
 func (e floatElement) IsNA() bool {
-	if e.nan || math.IsNaN(e.e) {
+	if e.null || math.IsNaN(e.e) {
 		return true
 	}
 	return false
 }
 
+// IsNull returns true only for missing elements.
+func (e floatElement) IsNull() bool {
+	return e.null
+}
+
 func (e floatElement) Type() Type {
 	return Float
 }
 
+// Val returns nil for missing elements, and the value otherwise, including
+// NaN.
 func (e floatElement) Val() ElementValue {
-	if e.IsNA() {
+	if e.IsNull() {
 		return nil
 	}
 	return float64(e.e)
 }
 
+// String returns "NaN" for missing elements, which is loaded back as missing,
+// and "nan" for NaN values, which is parsed back as NaN.
 func (e floatElement) String() string {
-	if e.IsNA() {
+	if e.IsNull() {
 		return "NaN"
 	}
+	if math.IsNaN(e.e) {
+		return "nan"
+	}
 	return fmt.Sprintf("%f", e.e)
 }
 
@@ -113,6 +133,17 @@ func (e floatElement) Bool() (bool, error) {
 	return false, fmt.Errorf("can't convert Float \"%v\" to bool", e.e)
 }
 
+// Time interprets the value as the number of seconds since the Unix epoch.
+func (e floatElement) Time() (time.Time, error) {
+	if e.IsNA() {
+		return time.Time{}, fmt.Errorf("can't convert NaN to time")
+	}
+	if math.IsInf(e.e, 0) {
+		return time.Time{}, fmt.Errorf("can't convert Inf to time")
+	}
+	return floatToTime(e.e), nil
+}
+
 func (e floatElement) Eq(elem Element) bool {
 	f := elem.Float()
 	if e.IsNA() || math.IsNaN(f) {
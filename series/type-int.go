@@ -4,38 +4,46 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"time"
 )
 
 type intElement struct {
-	e   int
-	nan bool
+	e    int
+	null bool
 }
 
 // force intElement struct to implement Element interface
 var _ Element = (*intElement)(nil)
 
 func (e *intElement) Set(value interface{}) {
-	e.nan = false
+	e.null = false
 	switch val := value.(type) {
 	case string:
 		if val == "NaN" {
-			e.nan = true
+			e.null = true
 			return
 		}
 		i, err := strconv.Atoi(value.(string))
 		if err != nil {
-			e.nan = true
+			e.null = true
 			return
 		}
 		e.e = i
 	case int:
 		e.e = int(val)
+	case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32:
+		i, ok := toInt64(val)
+		if !ok || int64(int(i)) != i {
+			e.null = true
+			return
+		}
+		e.e = int(i)
 	case float64:
 		f := val
 		if math.IsNaN(f) ||
 			math.IsInf(f, 0) ||
 			math.IsInf(f, 1) {
-			e.nan = true
+			e.null = true
 			return
 		}
 		e.e = int(f)
@@ -47,14 +55,18 @@ func (e *intElement) Set(value interface{}) {
 			e.e = 0
 		}
 	case Element:
+		if val.IsNull() {
+			e.null = true
+			return
+		}
 		v, err := val.Int()
 		if err != nil {
-			e.nan = true
+			e.null = true
 			return
 		}
 		e.e = v
 	default:
-		e.nan = true
+		e.null = true
 		return
 	}
 }
@@ -67,7 +79,11 @@ func (e intElement) Copy() Element {
 }
 
 func (e intElement) IsNA() bool {
-	return e.nan
+	return e.null
+}
+
+func (e intElement) IsNull() bool {
+	return e.null
 }
 
 func (e intElement) Type() Type {
@@ -115,6 +131,14 @@ func (e intElement) Bool() (bool, error) {
 	return false, fmt.Errorf("can't convert Int \"%v\" to bool", e.e)
 }
 
+// Time interprets the value as the number of seconds since the Unix epoch.
+func (e intElement) Time() (time.Time, error) {
+	if e.IsNA() {
+		return time.Time{}, fmt.Errorf("can't convert NaN to time")
+	}
+	return time.Unix(int64(e.e), 0).UTC(), nil
+}
+
 func (e intElement) Eq(elem Element) bool {
 	i, err := elem.Int()
 	if err != nil || e.IsNA() {
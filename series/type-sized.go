@@ -0,0 +1,455 @@
+package series
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// sizedValue is the set of Go types backing the numeric types with an explicit
+// width: Int64, Int32, Uint64 and Float32.
+type sizedValue interface {
+	int32 | int64 | uint64 | float32
+}
+
+// sizedType returns the Series Type that corresponds with T.
+func sizedType[T sizedValue]() Type {
+	var zero T
+	switch any(zero).(type) {
+	case int32:
+		return Int32
+	case int64:
+		return Int64
+	case uint64:
+		return Uint64
+	}
+	return Float32
+}
+
+type sizedElement[T sizedValue] struct {
+	e    T
+	null bool
+}
+
+// force sizedElement struct to implement Element interface
+var (
+	_ Element = (*sizedElement[int32])(nil)
+	_ Element = (*sizedElement[int64])(nil)
+	_ Element = (*sizedElement[uint64])(nil)
+	_ Element = (*sizedElement[float32])(nil)
+)
+
+// Set stores value converted to T. Values that can't be represented by T, such
+// as negative numbers on Uint64 elements, are set as missing.
+func (e *sizedElement[T]) Set(value interface{}) {
+	var ok bool
+	e.e, ok = convertSized[T](value)
+	e.null = !ok
+}
+
+func (e sizedElement[T]) Copy() Element {
+	return &sizedElement[T]{e.e, e.null}
+}
+
+// IsNA returns true for missing elements but also for valid NaN values of
+// Float32 elements.
+func (e sizedElement[T]) IsNA() bool {
+	return e.null || e.e != e.e
+}
+
+func (e sizedElement[T]) IsNull() bool {
+	return e.null
+}
+
+func (e sizedElement[T]) Type() Type {
+	return sizedType[T]()
+}
+
+func (e sizedElement[T]) Val() ElementValue {
+	if e.IsNull() {
+		return nil
+	}
+	return e.e
+}
+
+// String formats NaN values as "nan", to tell them from missing elements, as
+// for Float.
+func (e sizedElement[T]) String() string {
+	if e.IsNull() {
+		return "NaN"
+	}
+	if e.e != e.e {
+		return "nan"
+	}
+	if e.Type() == Float32 {
+		return fmt.Sprintf("%f", float64(e.e))
+	}
+	return fmt.Sprint(e.e)
+}
+
+func (e sizedElement[T]) Int() (int, error) {
+	if e.IsNA() {
+		return 0, fmt.Errorf("can't convert NaN to int")
+	}
+	i, ok := toInt64(e.e)
+	if !ok || int64(int(i)) != i {
+		return 0, fmt.Errorf("can't convert %s \"%v\" to int", e.Type(), e.e)
+	}
+	return int(i), nil
+}
+
+func (e sizedElement[T]) Float() float64 {
+	if e.IsNA() {
+		return math.NaN()
+	}
+	return float64(e.e)
+}
+
+func (e sizedElement[T]) Bool() (bool, error) {
+	if e.IsNA() {
+		return false, fmt.Errorf("can't convert NaN to bool")
+	}
+	switch e.e {
+	case 1:
+		return true, nil
+	case 0:
+		return false, nil
+	}
+	return false, fmt.Errorf("can't convert %s \"%v\" to bool", e.Type(), e.e)
+}
+
+// Time interprets the value as the number of seconds since the Unix epoch.
+func (e sizedElement[T]) Time() (time.Time, error) {
+	if e.IsNA() {
+		return time.Time{}, fmt.Errorf("can't convert NaN to time")
+	}
+	if e.Type() == Float32 {
+		f := float64(e.e)
+		if math.IsInf(f, 0) {
+			return time.Time{}, fmt.Errorf("can't convert Inf to time")
+		}
+		return floatToTime(f), nil
+	}
+	i, ok := toInt64(e.e)
+	if !ok {
+		return time.Time{}, fmt.Errorf("can't convert %s \"%v\" to time", e.Type(), e.e)
+	}
+	return time.Unix(i, 0).UTC(), nil
+}
+
+// compare compares the values of e and elem exactly when both are integers, and
+// as floats otherwise. It returns false if any of them is missing or NaN.
+func (e sizedElement[T]) compare(elem Element) (int, bool) {
+	if e.IsNA() || elem.IsNA() {
+		return 0, false
+	}
+	a, _ := normalizeNumber(e.e)
+	b, ok := normalizeNumber(elem.Val())
+	if !ok {
+		f := elem.Float()
+		if math.IsNaN(f) {
+			return 0, false
+		}
+		b = f
+	}
+	return compareNumbers(a, b), true
+}
+
+func (e sizedElement[T]) Eq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c == 0
+}
+
+func (e sizedElement[T]) Neq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c != 0
+}
+
+func (e sizedElement[T]) Less(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c < 0
+}
+
+func (e sizedElement[T]) LessEq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c <= 0
+}
+
+func (e sizedElement[T]) Greater(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c > 0
+}
+
+func (e sizedElement[T]) GreaterEq(elem Element) bool {
+	c, ok := e.compare(elem)
+	return ok && c >= 0
+}
+
+// sizedElements is the concrete implementation of Elements for the numeric
+// types with an explicit width.
+type sizedElements[T sizedValue] []sizedElement[T]
+
+func (e sizedElements[T]) Len() int           { return len(e) }
+func (e sizedElements[T]) Elem(i int) Element { return &e[i] }
+
+// sizedOps are the operations on sizedElements used by Series methods that
+// can't name their type parameter.
+type sizedOps interface {
+	Elements
+	subset(idx []int) Elements
+	concat(other Elements) Elements
+	clone() Elements
+}
+
+func (e sizedElements[T]) subset(idx []int) Elements {
+	elements := make(sizedElements[T], len(idx))
+	for k, i := range idx {
+		elements[k] = e[i]
+	}
+	return elements
+}
+
+func (e sizedElements[T]) concat(other Elements) Elements {
+	return append(e, other.(sizedElements[T])...)
+}
+
+func (e sizedElements[T]) clone() Elements {
+	elements := make(sizedElements[T], len(e))
+	copy(elements, e)
+	return elements
+}
+
+// newSizedElements returns n elements of the given type, which must be one of
+// Int64, Int32, Uint64 or Float32.
+func newSizedElements(t Type, n int) Elements {
+	switch t {
+	case Int32:
+		return make(sizedElements[int32], n)
+	case Int64:
+		return make(sizedElements[int64], n)
+	case Uint64:
+		return make(sizedElements[uint64], n)
+	}
+	return make(sizedElements[float32], n)
+}
+
+// convertSized converts value to T, reporting whether it is valid and can be
+// represented by T. Elements are converted through their value.
+func convertSized[T sizedValue](value interface{}) (T, bool) {
+	var zero T
+	if elem, ok := value.(Element); ok {
+		if elem.IsNull() {
+			return zero, false
+		}
+		switch v := elem.Val().(type) {
+		case int, int32, int64, uint64, float32, float64, bool, string:
+			value = v
+		default:
+			// NaN and Time values are converted through their float value
+			value = elem.Float()
+		}
+	}
+	switch any(zero).(type) {
+	case float32:
+		f, ok := toFloat64(value)
+		return T(f), ok
+	case uint64:
+		u, ok := toUint64(value)
+		return T(u), ok
+	}
+	i, ok := toInt64(value)
+	if !ok || int64(T(i)) != i {
+		return zero, false
+	}
+	return T(i), true
+}
+
+// toInt64 converts a Go value to int64, truncating floats. It returns false if
+// the value is not a number or is out of range.
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return int64(v), uint64(v) <= math.MaxInt64
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), v <= math.MaxInt64
+	case float32:
+		return toInt64(float64(v))
+	case float64:
+		if math.IsNaN(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// toUint64 converts a Go value to uint64, truncating floats. It returns false
+// if the value is not a number or is out of range.
+func toUint64(value interface{}) (uint64, bool) {
+	switch v := value.(type) {
+	case string:
+		u, err := strconv.ParseUint(v, 10, 64)
+		return u, err == nil
+	case uint:
+		return uint64(v), true
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case float32:
+		return toUint64(float64(v))
+	case float64:
+		if math.IsNaN(v) || v < 0 || v >= math.MaxUint64 {
+			return 0, false
+		}
+		return uint64(v), true
+	}
+	i, ok := toInt64(value)
+	return uint64(i), ok && i >= 0
+}
+
+// toFloat64 converts a Go value to float64. It returns false if the value is
+// not a number.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case string:
+		if v == "NaN" {
+			return 0, false
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case uint:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	i, ok := toInt64(value)
+	return float64(i), ok
+}
+
+// normalizeNumber converts a Go value to int64, uint64 or float64, so that it
+// can be compared with compareNumbers. Integers are kept as int64 whenever
+// they fit. Strings are parsed.
+func normalizeNumber(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case uint64:
+		if v > math.MaxInt64 {
+			return v, true
+		}
+		return int64(v), true
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i, true
+		}
+		if u, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return u, true
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return toInt64(value)
+}
+
+// compareNumbers compares two values returned by normalizeNumber. Integers are
+// compared exactly. Neither of them can be NaN.
+func compareNumbers(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return cmp.Compare(a, b)
+		case uint64:
+			// b is bigger than any int64
+			return -1
+		}
+	case uint64:
+		switch b.(type) {
+		case int64:
+			return 1
+		case uint64:
+			return cmp.Compare(a, b.(uint64))
+		}
+	}
+	return cmp.Compare(numberFloat(a), numberFloat(b))
+}
+
+func numberFloat(v interface{}) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return v.(float64)
+}
+
+// PromoteTypes returns the type of the result of arithmetic between Series of
+// the numeric types a and b. Integers of different widths promote to the
+// widest one. Uint64 mixed with signed integers, as well as any mix of
+// different types with floats, promotes to Float, since float32 can't hold
+// every int32 value exactly.
+func PromoteTypes(a, b Type) (Type, error) {
+	if !numeric(a) || !numeric(b) {
+		return "", fmt.Errorf("can't promote types %s and %s", a, b)
+	}
+	if a == b {
+		return a, nil
+	}
+	rank := map[Type]int{Int32: 0, Int: 1, Int64: 2}
+	ra, intA := rank[a]
+	rb, intB := rank[b]
+	switch {
+	case intA && intB && ra > rb:
+		return a, nil
+	case intA && intB:
+		return b, nil
+	}
+	return Float, nil
+}
+
+// numeric returns whether t is one of the numeric Series types.
+func numeric(t Type) bool {
+	switch t {
+	case Int, Float, Int64, Int32, Uint64, Float32:
+		return true
+	}
+	return false
+}
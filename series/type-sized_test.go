@@ -0,0 +1,184 @@
+package series
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSized_New(t *testing.T) {
+	table := []struct {
+		series   Series
+		t        Type
+		expected []string
+		nulls    []bool
+	}{
+		{
+			New([]string{"9223372036854775807", "-3", "NaN", "1.5"}, Int64, "A"),
+			Int64,
+			[]string{"9223372036854775807", "-3", "NaN", "NaN"},
+			[]bool{false, false, true, true},
+		},
+		{
+			New([]interface{}{int64(5), 2147483648, -2147483648, 2.9, true, nil}, Int32, "A"),
+			Int32,
+			[]string{"5", "NaN", "-2147483648", "2", "1", "NaN"},
+			[]bool{false, true, false, false, false, true},
+		},
+		{
+			New([]interface{}{"18446744073709551615", -1, 3.0, uint8(7)}, Uint64, "A"),
+			Uint64,
+			[]string{"18446744073709551615", "NaN", "3", "7"},
+			[]bool{false, true, false, false},
+		},
+		{
+			New([]float32{1.5, float32(math.NaN())}, Float32, "A"),
+			Float32,
+			[]string{"1.500000", "nan"},
+			[]bool{false, false},
+		},
+		{
+			New(Ints([]int{1, 2}), Float32, "A"),
+			Float32,
+			[]string{"1.000000", "2.000000"},
+			[]bool{false, false},
+		},
+		{
+			New(New([]int64{1 << 40, -1}, Int64, "A"), Int, "A"),
+			Int,
+			[]string{"1099511627776", "-1"},
+			[]bool{false, false},
+		},
+		{
+			New(New([]float32{0.25, 2}, Float32, "A"), Float, "A"),
+			Float,
+			[]string{"0.250000", "2.000000"},
+			[]bool{false, false},
+		},
+		{
+			New([]int16{3, -4}, Int, "A"),
+			Int,
+			[]string{"3", "-4"},
+			[]bool{false, false},
+		},
+	}
+	for testnum, test := range table {
+		if test.series.Type() != test.t {
+			t.Errorf("Test:%v\nExpected type %v, got %v", testnum, test.t, test.series.Type())
+		}
+		if got := test.series.Records(); !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got)
+		}
+		if got := test.series.IsNull(); !reflect.DeepEqual(test.nulls, got) {
+			t.Errorf("Test:%v\nExpected nulls:\n%v\nReceived:\n%v", testnum, test.nulls, got)
+		}
+	}
+}
+
+func TestSized_Val(t *testing.T) {
+	table := []struct {
+		series   Series
+		expected interface{}
+	}{
+		{New([]int{1}, Int64, "A"), int64(1)},
+		{New([]int{1}, Int32, "A"), int32(1)},
+		{New([]int{1}, Uint64, "A"), uint64(1)},
+		{New([]int{1}, Float32, "A"), float32(1)},
+	}
+	for testnum, test := range table {
+		if got := test.series.Val(0); got != test.expected {
+			t.Errorf("Test:%v\nExpected %T %v, got %T %v", testnum, test.expected, test.expected, got, got)
+		}
+	}
+}
+
+func TestSized_Compare(t *testing.T) {
+	big := New([]string{"9223372036854775807", "9223372036854775806", "NaN"}, Int64, "A")
+	table := []struct {
+		series     Series
+		comparator Comparator
+		comparando interface{}
+		expected   []bool
+	}{
+		{big, Eq, "9223372036854775807", []bool{true, false, false}},
+		{big, Less, New([]string{"9223372036854775807"}, Int64, ""), []bool{false, true, false}},
+		{big, Greater, Ints([]int{0, 0, 0}), []bool{true, true, false}},
+		{
+			New([]string{"18446744073709551615", "1"}, Uint64, "A"),
+			Greater, New([]int{1}, Int64, ""),
+			[]bool{true, false},
+		},
+		{New([]float32{0.5, 1.5}, Float32, "A"), Less, 1, []bool{true, false}},
+		{New([]int32{1, 2, 3}, Int32, "A"), In, []int{1, 3}, []bool{true, false, true}},
+	}
+	for testnum, test := range table {
+		b := test.series.Compare(test.comparator, test.comparando)
+		if b.Err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, b.Err)
+			continue
+		}
+		got, _ := b.Bool()
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, got)
+		}
+	}
+}
+
+func TestSized_Methods(t *testing.T) {
+	a := New([]interface{}{int32(3), nil, int32(1), int32(2)}, Int32, "A")
+	if got := a.Order(false); !reflect.DeepEqual([]int{2, 3, 0, 1}, got) {
+		t.Errorf("Expected order [2 3 0 1], got %v", got)
+	}
+	if got := a.Sum(); got != 6 {
+		t.Errorf("Expected sum 6, got %v", got)
+	}
+	if got := a.Max(); got != 3 {
+		t.Errorf("Expected max 3, got %v", got)
+	}
+
+	b := a.Subset([]int{0, 2})
+	b.Append(New([]int{5}, Int, ""))
+	if b.Type() != Int32 || !reflect.DeepEqual([]string{"3", "1", "5"}, b.Records()) {
+		t.Errorf("Expected Int32 [3 1 5], got %v %v", b.Type(), b.Records())
+	}
+	c := b.Copy()
+	c.Elem(0).Set(10)
+	if b.Elem(0).String() != "3" || c.Elem(0).String() != "10" {
+		t.Errorf("Expected copy to be independent, got %v and %v", b.Records(), c.Records())
+	}
+
+	if _, err := New([]string{"18446744073709551615"}, Uint64, "").Elem(0).Int(); err == nil {
+		t.Errorf("Expected error converting a big Uint64 to int")
+	}
+}
+
+func TestPromoteTypes(t *testing.T) {
+	table := []struct {
+		a, b     Type
+		expected Type
+	}{
+		{Int32, Int32, Int32},
+		{Int32, Int, Int},
+		{Int, Int64, Int64},
+		{Int64, Int32, Int64},
+		{Float32, Float32, Float32},
+		{Float32, Int32, Float},
+		{Int32, Float32, Float},
+		{Float32, Int64, Float},
+		{Uint64, Int, Float},
+		{Uint64, Uint64, Uint64},
+		{Float, Int32, Float},
+	}
+	for testnum, test := range table {
+		got, err := PromoteTypes(test.a, test.b)
+		if err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if got != test.expected {
+			t.Errorf("Test:%v\nExpected %v, got %v", testnum, test.expected, got)
+		}
+	}
+	if _, err := PromoteTypes(String, Int); err == nil {
+		t.Errorf("Expected error promoting String")
+	}
+}
@@ -5,23 +5,24 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type stringElement struct {
-	e   string
-	nan bool
+	e    string
+	null bool
 }
 
 // force stringElement struct to implement Element interface
 var _ Element = (*stringElement)(nil)
 
 func (e *stringElement) Set(value interface{}) {
-	e.nan = false
+	e.null = false
 	switch val := value.(type) {
 	case string:
 		e.e = string(val)
 		if e.e == "NaN" {
-			e.nan = true
+			e.null = true
 			return
 		}
 	case int:
@@ -36,9 +37,13 @@ func (e *stringElement) Set(value interface{}) {
 			e.e = "false"
 		}
 	case Element:
+		if val.IsNull() {
+			e.null = true
+			return
+		}
 		e.e = val.String()
 	default:
-		e.nan = true
+		e.null = true
 		return
 	}
 }
@@ -51,7 +56,11 @@ func (e stringElement) Copy() Element {
 }
 
 func (e stringElement) IsNA() bool {
-	return e.nan
+	return e.null
+}
+
+func (e stringElement) IsNull() bool {
+	return e.null
 }
 
 func (e stringElement) Type() Type {
@@ -103,6 +112,13 @@ func (e stringElement) Bool() (bool, error) {
 	return false, fmt.Errorf("can't convert String \"%v\" to bool", e.e)
 }
 
+func (e stringElement) Time() (time.Time, error) {
+	if e.IsNA() {
+		return time.Time{}, fmt.Errorf("can't convert NaN to time")
+	}
+	return ParseTime(e.e)
+}
+
 func (e stringElement) Eq(elem Element) bool {
 	if e.IsNA() || elem.IsNA() {
 		return false
@@ -0,0 +1,191 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// timeLayouts are the layouts tried, in order, when parsing a string into a
+// Time element.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+type timeElement struct {
+	e    time.Time
+	null bool
+}
+
+// force timeElement struct to implement Element interface
+var _ Element = (*timeElement)(nil)
+
+func (e *timeElement) Set(value interface{}) {
+	e.null = false
+	switch val := value.(type) {
+	case string:
+		if val == "NaN" {
+			e.null = true
+			return
+		}
+		t, err := ParseTime(val)
+		if err != nil {
+			e.null = true
+			return
+		}
+		e.e = t
+	case int:
+		e.e = time.Unix(int64(val), 0).UTC()
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			e.null = true
+			return
+		}
+		e.e = floatToTime(val)
+	case time.Time:
+		e.e = val
+	case Element:
+		if val.IsNull() {
+			e.null = true
+			return
+		}
+		t, err := val.Time()
+		if err != nil {
+			e.null = true
+			return
+		}
+		e.e = t
+	default:
+		e.null = true
+		return
+	}
+}
+
+func (e timeElement) Copy() Element {
+	if e.IsNA() {
+		return &timeElement{time.Time{}, true}
+	}
+	return &timeElement{e.e, false}
+}
+
+func (e timeElement) IsNA() bool {
+	return e.null
+}
+
+func (e timeElement) IsNull() bool {
+	return e.null
+}
+
+func (e timeElement) Type() Type {
+	return Time
+}
+
+func (e timeElement) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.e
+}
+
+func (e timeElement) String() string {
+	if e.IsNA() {
+		return "NaN"
+	}
+	return e.e.Format(time.RFC3339Nano)
+}
+
+// Int returns the number of seconds elapsed since the Unix epoch.
+func (e timeElement) Int() (int, error) {
+	if e.IsNA() {
+		return 0, fmt.Errorf("can't convert NaN to int")
+	}
+	return int(e.e.Unix()), nil
+}
+
+// Float returns the number of seconds elapsed since the Unix epoch, including
+// the fractional part.
+func (e timeElement) Float() float64 {
+	if e.IsNA() {
+		return math.NaN()
+	}
+	return float64(e.e.Unix()) + float64(e.e.Nanosecond())/1e9
+}
+
+func (e timeElement) Bool() (bool, error) {
+	return false, fmt.Errorf("can't convert Time to bool")
+}
+
+func (e timeElement) Time() (time.Time, error) {
+	if e.IsNA() {
+		return time.Time{}, fmt.Errorf("can't convert NaN to time")
+	}
+	return e.e, nil
+}
+
+func (e timeElement) Eq(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return e.e.Equal(t)
+}
+
+func (e timeElement) Neq(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return !e.e.Equal(t)
+}
+
+func (e timeElement) Less(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return e.e.Before(t)
+}
+
+func (e timeElement) LessEq(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return !e.e.After(t)
+}
+
+func (e timeElement) Greater(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return e.e.After(t)
+}
+
+func (e timeElement) GreaterEq(elem Element) bool {
+	t, err := elem.Time()
+	if err != nil || e.IsNA() {
+		return false
+	}
+	return !e.e.Before(t)
+}
+
+// ParseTime parses a string the same way it is parsed when set on a Time
+// element. RFC 3339 timestamps, "2006-01-02 15:04:05" datetimes and
+// "2006-01-02" dates are supported.
+func ParseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("can't parse %q as time", s)
+}
+
+// floatToTime converts a number of seconds since the Unix epoch to a UTC time.
+func floatToTime(f float64) time.Time {
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
@@ -0,0 +1,242 @@
+package series
+
+import (
+	"fmt"
+	"time"
+)
+
+// TypedValue is the set of Go types that can back a Typed Series.
+type TypedValue interface {
+	int | float64 | string | bool | time.Time
+}
+
+// Typed is a type safe Series backed by a native slice of values. Missing
+// values are tracked on a validity bitmap instead of on every element, so that
+// reading the values does not require boxing them into an Element.
+//
+// A Typed Series can be converted to and from a regular Series with the
+// Series method and the FromSeries function.
+type Typed[T TypedValue] struct {
+	Name   string // The name of the series
+	values []T    // The values of the elements
+	valid  bitmap // Marks which of the values are not missing
+}
+
+// bitmap is a compact set of booleans stored one per bit.
+type bitmap []uint64
+
+func newBitmap(n int, set bool) bitmap {
+	b := make(bitmap, (n+63)/64)
+	if set {
+		for i := range b {
+			b[i] = ^uint64(0)
+		}
+	}
+	return b
+}
+
+func (b bitmap) get(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (b bitmap) set(i int, v bool) {
+	if v {
+		b[i/64] |= 1 << uint(i%64)
+	} else {
+		b[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// NewTyped is the constructor for a Typed Series where all the given values
+// are valid. The values are copied.
+func NewTyped[T TypedValue](values []T, name string) Typed[T] {
+	vals := make([]T, len(values))
+	copy(vals, values)
+	return Typed[T]{
+		Name:   name,
+		values: vals,
+		valid:  newBitmap(len(vals), true),
+	}
+}
+
+// NewTypedWithValidity is the constructor for a Typed Series with missing
+// values. The element i is considered missing when valid[i] is false.
+func NewTypedWithValidity[T TypedValue](values []T, valid []bool, name string) (Typed[T], error) {
+	if len(values) != len(valid) {
+		return Typed[T]{}, fmt.Errorf("typed: values and validity have different lengths")
+	}
+	t := NewTyped(values, name)
+	for i, v := range valid {
+		t.valid.set(i, v)
+	}
+	return t, nil
+}
+
+// Len returns the length of a Typed Series.
+func (t Typed[T]) Len() int {
+	return len(t.values)
+}
+
+// Type returns the Series Type that corresponds with T.
+func (t Typed[T]) Type() Type {
+	return typeOf[T]()
+}
+
+// Values returns the underlying values of the Typed Series. The returned slice
+// is not a copy and the value of missing elements is unspecified, so it should
+// be used together with IsValid.
+func (t Typed[T]) Values() []T {
+	return t.values
+}
+
+// At returns the value on the given index and whether it is valid. Will panic
+// if the index is out of bounds.
+func (t Typed[T]) At(i int) (T, bool) {
+	if i < 0 || i >= len(t.values) {
+		panic(fmt.Sprintf("typed: index %d out of range [0:%d]", i, len(t.values)))
+	}
+	return t.values[i], t.valid.get(i)
+}
+
+// IsValid returns false if the element on the given index is missing.
+func (t Typed[T]) IsValid(i int) bool {
+	return t.valid.get(i)
+}
+
+// NullCount returns the number of missing elements.
+func (t Typed[T]) NullCount() int {
+	n := 0
+	for i := range t.values {
+		if !t.valid.get(i) {
+			n++
+		}
+	}
+	return n
+}
+
+// Append adds a new valid value to the end of the Typed Series. The Typed
+// Series is modified in place.
+func (t *Typed[T]) Append(v T) {
+	t.appendValue(v, true)
+}
+
+// AppendNull adds a missing value to the end of the Typed Series. The Typed
+// Series is modified in place.
+func (t *Typed[T]) AppendNull() {
+	var zero T
+	t.appendValue(zero, false)
+}
+
+func (t *Typed[T]) appendValue(v T, valid bool) {
+	n := len(t.values)
+	t.values = append(t.values, v)
+	if n/64 >= len(t.valid) {
+		t.valid = append(t.valid, 0)
+	}
+	t.valid.set(n, valid)
+}
+
+// Series converts the Typed Series to a regular Series of the matching Type.
+func (t Typed[T]) Series() Series {
+	ret := Series{
+		Name: t.Name,
+		t:    t.Type(),
+	}
+	n := len(t.values)
+	switch values := any(t.values).(type) {
+	case []int:
+		elements := make(intElements, n)
+		for i := 0; i < n; i++ {
+			elements[i] = intElement{values[i], !t.valid.get(i)}
+		}
+		ret.elements = elements
+	case []float64:
+		elements := make(floatElements, n)
+		for i := 0; i < n; i++ {
+			elements[i] = floatElement{values[i], !t.valid.get(i)}
+		}
+		ret.elements = elements
+	case []string:
+		elements := make(stringElements, n)
+		for i := 0; i < n; i++ {
+			elements[i] = stringElement{values[i], !t.valid.get(i)}
+		}
+		ret.elements = elements
+	case []bool:
+		elements := make(boolElements, n)
+		for i := 0; i < n; i++ {
+			elements[i] = boolElement{values[i], !t.valid.get(i)}
+		}
+		ret.elements = elements
+	case []time.Time:
+		elements := make(timeElements, n)
+		for i := 0; i < n; i++ {
+			elements[i] = timeElement{values[i], !t.valid.get(i)}
+		}
+		ret.elements = elements
+	}
+	return ret
+}
+
+// FromSeries converts a Series to a Typed Series. The Type of the Series must
+// match with T.
+func FromSeries[T TypedValue](s Series) (Typed[T], error) {
+	if err := s.Err; err != nil {
+		return Typed[T]{}, fmt.Errorf("typed: series has errors: %v", err)
+	}
+	if want := typeOf[T](); s.t != want {
+		return Typed[T]{}, fmt.Errorf("typed: can't convert %v Series to %v", s.t, want)
+	}
+	n := s.Len()
+	ret := Typed[T]{
+		Name:   s.Name,
+		values: make([]T, n),
+		valid:  newBitmap(n, false),
+	}
+	switch values := any(ret.values).(type) {
+	case []int:
+		for i, e := range s.elements.(intElements) {
+			values[i] = e.e
+			ret.valid.set(i, !e.IsNull())
+		}
+	case []float64:
+		for i, e := range s.elements.(floatElements) {
+			values[i] = e.e
+			ret.valid.set(i, !e.IsNull())
+		}
+	case []string:
+		for i, e := range s.elements.(stringElements) {
+			values[i] = e.e
+			ret.valid.set(i, !e.IsNull())
+		}
+	case []bool:
+		for i, e := range s.elements.(boolElements) {
+			values[i] = e.e
+			ret.valid.set(i, !e.IsNull())
+		}
+	case []time.Time:
+		for i, e := range s.elements.(timeElements) {
+			values[i] = e.e
+			ret.valid.set(i, !e.IsNull())
+		}
+	}
+	return ret, nil
+}
+
+// typeOf returns the Series Type that corresponds with T.
+func typeOf[T TypedValue]() Type {
+	var zero T
+	switch any(zero).(type) {
+	case int:
+		return Int
+	case float64:
+		return Float
+	case string:
+		return String
+	case bool:
+		return Bool
+	case time.Time:
+		return Time
+	}
+	panic(fmt.Sprintf("unknown typed value %T", zero))
+}
@@ -0,0 +1,117 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTyped_At(t *testing.T) {
+	a, err := NewTypedWithValidity([]int{1, 2, 3}, []bool{true, false, true}, "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []struct {
+		value int
+		valid bool
+	}{
+		{1, true},
+		{0, false},
+		{3, true},
+	}
+	if a.Len() != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), a.Len())
+	}
+	for i, e := range expected {
+		v, ok := a.At(i)
+		if ok != e.valid || (ok && v != e.value) {
+			t.Errorf("Test:%v\nExpected:\n%v %v\nReceived:\n%v %v", i, e.value, e.valid, v, ok)
+		}
+	}
+	if a.NullCount() != 1 {
+		t.Errorf("Expected 1 null, got %d", a.NullCount())
+	}
+
+	_, err = NewTypedWithValidity([]int{1, 2, 3}, []bool{true}, "A")
+	if err == nil {
+		t.Errorf("Expected error on mismatched validity")
+	}
+}
+
+func TestTyped_Append(t *testing.T) {
+	a := NewTyped([]string{}, "A")
+	for i := 0; i < 130; i++ {
+		if i%2 == 0 {
+			a.Append("x")
+		} else {
+			a.AppendNull()
+		}
+	}
+	if a.Len() != 130 {
+		t.Fatalf("Expected length 130, got %d", a.Len())
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.IsValid(i) != (i%2 == 0) {
+			t.Errorf("Test:%v\nExpected valid: %v", i, i%2 == 0)
+		}
+	}
+}
+
+func TestTyped_Series(t *testing.T) {
+	floats, _ := NewTypedWithValidity([]float64{1.5, 0, 3}, []bool{true, false, true}, "F")
+	table := []struct {
+		series   Series
+		expected Series
+	}{
+		{
+			NewTyped([]int{1, 2, 3}, "I").Series(),
+			New([]int{1, 2, 3}, Int, "I"),
+		},
+		{
+			floats.Series(),
+			New([]string{"1.5", "NaN", "3"}, Float, "F"),
+		},
+		{
+			NewTyped([]string{"a", "b"}, "S").Series(),
+			New([]string{"a", "b"}, String, "S"),
+		},
+		{
+			NewTyped([]bool{true, false}, "B").Series(),
+			New([]bool{true, false}, Bool, "B"),
+		},
+	}
+	for testnum, test := range table {
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if test.series.Name != test.expected.Name {
+			t.Errorf("Test:%v\nExpected name:\n%v\nReceived:\n%v", testnum, test.expected.Name, test.series.Name)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), test.series.Records()) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, test.series)
+		}
+	}
+}
+
+func TestFromSeries(t *testing.T) {
+	s := New([]string{"1", "NaN", "3"}, Int, "I")
+	typed, err := FromSeries[int](s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if typed.Name != "I" || typed.Type() != Int {
+		t.Errorf("Expected Int Series named I, got %v %v", typed.Type(), typed.Name)
+	}
+	if !reflect.DeepEqual(typed.Values(), []int{1, 0, 3}) {
+		t.Errorf("Expected values %v, got %v", []int{1, 0, 3}, typed.Values())
+	}
+	if typed.IsValid(1) {
+		t.Errorf("Expected element 1 to be missing")
+	}
+	if !reflect.DeepEqual(typed.Series().Records(), s.Records()) {
+		t.Errorf("Expected round trip %v, got %v", s, typed.Series())
+	}
+
+	if _, err := FromSeries[float64](s); err == nil {
+		t.Errorf("Expected error converting Int Series to float64")
+	}
+}
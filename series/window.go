@@ -0,0 +1,194 @@
+package series
+
+import (
+	"fmt"
+)
+
+// RankMethod defines how Rank assigns ranks to tied elements.
+type RankMethod int
+
+const (
+	// RankAverage assigns to ties the average of their positions.
+	RankAverage RankMethod = iota
+	// RankMin assigns to ties the lowest of their positions.
+	RankMin
+	// RankMax assigns to ties the highest of their positions.
+	RankMax
+	// RankFirst assigns positions to ties in order of appearance.
+	RankFirst
+	// RankDense is like RankMin, but the rank always increases by one between
+	// groups of ties.
+	RankDense
+)
+
+// Shift returns a Series of the same type with the elements moved by the given
+// number of periods. Positive periods move the elements forward (lag), and
+// negative periods move them backward (lead). The positions left empty are
+// filled with missing elements.
+func (s Series) Shift(periods int) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	values := make([]interface{}, s.Len())
+	for i := range values {
+		if j := i - periods; j >= 0 && j < s.Len() {
+			values[i] = s.elements.Elem(j)
+		}
+	}
+	return New(values, s.Type(), s.Name)
+}
+
+// Diff returns a Float Series with the difference between every element and the
+// element the given number of periods before it. The elements without a
+// previous element, or where any of them is missing, are missing. Only numeric
+// and Bool Series are supported.
+func (s Series) Diff(periods int) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	if t := s.Type(); !numeric(t) && t != Bool {
+		return s.floatError(fmt.Errorf("diff: unsupported type %s", t))
+	}
+	values := make([]interface{}, s.Len())
+	for i := range values {
+		j := i - periods
+		if j < 0 || j >= s.Len() {
+			continue
+		}
+		a, b := s.elements.Elem(i), s.elements.Elem(j)
+		if a.IsNull() || b.IsNull() {
+			continue
+		}
+		values[i] = a.Float() - b.Float()
+	}
+	return New(values, Float, s.Name)
+}
+
+// CumSum returns a Float Series with the cumulative sum of the elements. Missing
+// elements are skipped and stay missing. Only numeric and Bool Series are
+// supported.
+func (s Series) CumSum() Series {
+	return s.cumulative("cumsum", 0, func(acc, x float64) float64 { return acc + x })
+}
+
+// CumProd returns a Float Series with the cumulative product of the elements.
+// Missing elements are skipped and stay missing. Only numeric and Bool
+// Series are supported.
+func (s Series) CumProd() Series {
+	return s.cumulative("cumprod", 1, func(acc, x float64) float64 { return acc * x })
+}
+
+// CumMax returns a Series of the same type with the maximum of the elements up
+// to every position. Missing elements are skipped and stay missing.
+func (s Series) CumMax() Series {
+	return s.cumulativeExtreme(Element.Greater)
+}
+
+// CumMin returns a Series of the same type with the minimum of the elements up
+// to every position. Missing elements are skipped and stay missing.
+func (s Series) CumMin() Series {
+	return s.cumulativeExtreme(Element.Less)
+}
+
+// Rank returns the rank of every element, starting at 1, in ascending order or
+// in descending order if reverse is true. Ties are ranked with the given
+// method. The result is a Float Series for RankAverage and an Int Series
+// otherwise. Missing and NaN elements are not ranked and stay missing.
+func (s Series) Rank(method RankMethod, reverse bool) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	t := Int
+	if method == RankAverage {
+		t = Float
+	}
+	if method < RankAverage || method > RankDense {
+		return s.floatError(fmt.Errorf("rank: unknown method %d", method))
+	}
+	values := make([]interface{}, s.Len())
+	var order []int
+	for _, i := range s.Order(reverse) {
+		if !s.elements.Elem(i).IsNA() {
+			order = append(order, i)
+		}
+	}
+	dense := 0
+	for start := 0; start < len(order); {
+		// Find the tied elements on order[start:end]
+		first := s.elements.Elem(order[start])
+		end := start + 1
+		for end < len(order) && first.Eq(s.elements.Elem(order[end])) {
+			end++
+		}
+		dense++
+		for k := start; k < end; k++ {
+			var rank interface{}
+			switch method {
+			case RankAverage:
+				rank = float64(start+end+1) / 2
+			case RankMin:
+				rank = start + 1
+			case RankMax:
+				rank = end
+			case RankFirst:
+				rank = k + 1
+			case RankDense:
+				rank = dense
+			}
+			values[order[k]] = rank
+		}
+		start = end
+	}
+	return New(values, t, s.Name)
+}
+
+// cumulative accumulates the float values of the elements with f, starting
+// from init.
+func (s Series) cumulative(name string, init float64, f func(acc, x float64) float64) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	if t := s.Type(); !numeric(t) && t != Bool {
+		return s.floatError(fmt.Errorf("%s: unsupported type %s", name, t))
+	}
+	values := make([]interface{}, s.Len())
+	acc := init
+	for i := range values {
+		e := s.elements.Elem(i)
+		if e.IsNull() {
+			continue
+		}
+		acc = f(acc, e.Float())
+		values[i] = acc
+	}
+	return New(values, Float, s.Name)
+}
+
+// cumulativeExtreme returns, for every position, the first element up to it for
+// which better holds against every other element.
+func (s Series) cumulativeExtreme(better func(a, b Element) bool) Series {
+	if err := s.Err; err != nil {
+		return s
+	}
+	values := make([]interface{}, s.Len())
+	var ret Element
+	for i := range values {
+		e := s.elements.Elem(i)
+		if e.IsNull() {
+			continue
+		}
+		if ret == nil || better(e, ret) {
+			ret = e
+		}
+		values[i] = ret
+	}
+	return New(values, s.Type(), s.Name)
+}
+
+// floatError returns an empty Float Series with the name of s and the given
+// error.
+func (s Series) floatError(err error) Series {
+	ret := New([]float64{}, Float, s.Name)
+	ret.Err = err
+	return ret
+}
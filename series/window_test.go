@@ -0,0 +1,118 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// checkSeries compares the type, values and missing elements of two Series.
+func checkSeries(t *testing.T, testnum int, expected, received Series) {
+	t.Helper()
+	if received.Err != nil {
+		t.Errorf("Test:%v\nError:%v", testnum, received.Err)
+		return
+	}
+	if expected.Type() != received.Type() {
+		t.Errorf("Test:%v\nExpected type:%v\nReceived type:%v", testnum, expected.Type(), received.Type())
+	}
+	if !reflect.DeepEqual(expected.Records(), received.Records()) {
+		t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, expected.Records(), received.Records())
+	}
+	if !reflect.DeepEqual(expected.IsNull(), received.IsNull()) {
+		t.Errorf("Test:%v\nExpected nulls:\n%v\nReceived nulls:\n%v", testnum, expected.IsNull(), received.IsNull())
+	}
+}
+
+func TestSeries_Shift(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		series   Series
+		periods  int
+		expected Series
+	}{
+		{Ints([]int{1, 2, 3, 4}), 1, New([]interface{}{nil, 1, 2, 3}, Int, "")},
+		{Ints([]int{1, 2, 3, 4}), -2, New([]interface{}{3, 4, nil, nil}, Int, "")},
+		{Ints([]int{1, 2, 3, 4}), 0, Ints([]int{1, 2, 3, 4})},
+		{Ints([]int{1, 2}), 5, New([]interface{}{nil, nil}, Int, "")},
+		{Strings([]string{"a", "b", "c"}), 1, New([]interface{}{nil, "a", "b"}, String, "")},
+		{
+			Times([]time.Time{base, base.Add(time.Hour)}),
+			-1,
+			New([]interface{}{base.Add(time.Hour), nil}, Time, ""),
+		},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.series.Shift(test.periods))
+	}
+}
+
+func TestSeries_Diff(t *testing.T) {
+	tests := []struct {
+		series   Series
+		periods  int
+		expected Series
+	}{
+		{Ints([]int{1, 3, 6, 10}), 1, New([]interface{}{nil, 2, 3, 4}, Float, "")},
+		{Ints([]int{1, 3, 6, 10}), 2, New([]interface{}{nil, nil, 5, 7}, Float, "")},
+		{Ints([]int{1, 3, 6, 10}), -1, New([]interface{}{-2, -3, -4, nil}, Float, "")},
+		{New([]interface{}{1.5, nil, 4, 8}, Float, ""), 1, New([]interface{}{nil, nil, nil, 4}, Float, "")},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.series.Diff(test.periods))
+	}
+	if s := Strings([]string{"a"}).Diff(1); s.Err == nil {
+		t.Errorf("Expected error for String Series")
+	}
+}
+
+func TestSeries_Cumulative(t *testing.T) {
+	tests := []struct {
+		received Series
+		expected Series
+	}{
+		{Ints([]int{1, 2, 3, 4}).CumSum(), Floats([]float64{1, 3, 6, 10})},
+		{New([]interface{}{1, nil, 3}, Int, "").CumSum(), New([]interface{}{1, nil, 4}, Float, "")},
+		{Bools([]bool{true, false, true}).CumSum(), Floats([]float64{1, 1, 2})},
+		{Floats([]float64{1, 2, 3, 4}).CumProd(), Floats([]float64{1, 2, 6, 24})},
+		{New([]interface{}{2, nil, 3}, Int, "").CumProd(), New([]interface{}{2, nil, 6}, Float, "")},
+		{Ints([]int{1, 3, 2, 5, 4}).CumMax(), Ints([]int{1, 3, 3, 5, 5})},
+		{New([]interface{}{nil, 3, nil, 1}, Int, "").CumMax(), New([]interface{}{nil, 3, nil, 3}, Int, "")},
+		{Ints([]int{4, 5, 2, 3, 1}).CumMin(), Ints([]int{4, 4, 2, 2, 1})},
+		{Strings([]string{"b", "c", "a"}).CumMin(), Strings([]string{"b", "b", "a"})},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, test.received)
+	}
+	for testnum, s := range []Series{
+		Strings([]string{"a"}).CumSum(),
+		Times([]time.Time{time.Now()}).CumProd(),
+	} {
+		if s.Err == nil {
+			t.Errorf("Test:%v\nExpected error", testnum)
+		}
+	}
+}
+
+func TestSeries_Rank(t *testing.T) {
+	s := New([]interface{}{3, 1, nil, 3, 2}, Int, "")
+	tests := []struct {
+		method   RankMethod
+		reverse  bool
+		expected Series
+	}{
+		{RankAverage, false, New([]interface{}{3.5, 1, nil, 3.5, 2}, Float, "")},
+		{RankMin, false, New([]interface{}{3, 1, nil, 3, 2}, Int, "")},
+		{RankMax, false, New([]interface{}{4, 1, nil, 4, 2}, Int, "")},
+		{RankFirst, false, New([]interface{}{3, 1, nil, 4, 2}, Int, "")},
+		{RankDense, false, New([]interface{}{3, 1, nil, 3, 2}, Int, "")},
+		{RankFirst, true, New([]interface{}{1, 4, nil, 2, 3}, Int, "")},
+		{RankDense, true, New([]interface{}{1, 3, nil, 1, 2}, Int, "")},
+	}
+	for testnum, test := range tests {
+		checkSeries(t, testnum, test.expected, s.Rank(test.method, test.reverse))
+	}
+	if r := s.Rank(RankMethod(10), false); r.Err == nil {
+		t.Errorf("Expected error for unknown method")
+	}
+}